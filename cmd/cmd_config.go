@@ -172,6 +172,9 @@ func addHConfigFlags(commandRun *cobra.Command) {
 	commandRun.Flags().BoolVar(&defaultConfigs.InboundOptions.EnableTunService, "tun-service", false, "Enable Tun Service")
 	commandRun.Flags().BoolVar(&defaultConfigs.InboundOptions.SetSystemProxy, "system-proxy", false, "Enable System Proxy")
 	commandRun.Flags().Uint16Var(&defaultConfigs.InboundOptions.MixedPort, "in-proxy-port", 2334, "Input Mixed Port")
+	commandRun.Flags().StringVar(&defaultConfigs.InboundOptions.TransparentProxy, "transparent-proxy", "", "Transparent proxy for a linux gateway (tproxy, redirect)")
+	commandRun.Flags().Uint16Var(&defaultConfigs.InboundOptions.TProxyPort, "tproxy-port", 12335, "Transparent Proxy Port")
+	commandRun.Flags().StringVar(&defaultConfigs.InboundOptions.TransparentProxyFirewall, "firewall", config.FirewallAuto, "Firewall rules for the transparent proxy (auto, nftables, iptables, none)")
 	commandRun.Flags().BoolVar(&defaultConfigs.TLSTricks.EnableFragment, "fragment", false, "Enable Fragment")
 	commandRun.Flags().StringVar(&defaultConfigs.TLSTricks.FragmentSize, "fragment-size", "2-4", "FragmentSize")
 	commandRun.Flags().StringVar(&defaultConfigs.TLSTricks.FragmentSleep, "fragment-sleep", "2-4", "FragmentSleep")
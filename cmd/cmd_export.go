@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hiddify/hiddify-core/config"
+	v2 "github.com/hiddify/hiddify-core/v2"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commandExportFormat     string
+	commandExportOutputPath string
+	commandExportTags       []string
+)
+
+var commandExport = &cobra.Command{
+	Use:   "export",
+	Short: "Export outbounds as share links, clash or sing-box config",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := export(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandExport.Flags().StringVarP(&commandExportFormat, "format", "f", string(config.ExportFormatShareLink), "output format: uri, clash or singbox")
+	commandExport.Flags().StringVarP(&commandExportOutputPath, "output", "o", "", "write result to file path instead of stdout")
+	commandExport.Flags().StringSliceVarP(&commandExportTags, "tag", "t", nil, "only export these outbound tags")
+
+	mainCommand.AddCommand(commandExport)
+}
+
+func export(path string) error {
+	if workingDir != "" && !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		path = filepath.Join(workingDir, path)
+	}
+	content, err := v2.ReadConfigContent(path)
+	if err != nil {
+		return err
+	}
+	result, err := config.ExportConfigContent(content.Config, config.ExportFormat(commandExportFormat), commandExportTags)
+	if err != nil {
+		return err
+	}
+	for _, tag := range result.Skipped {
+		fmt.Fprintf(os.Stderr, "skipped %s: not supported in %s format\n", tag, commandExportFormat)
+	}
+	if commandExportOutputPath != "" {
+		outputPath, _ := filepath.Abs(filepath.Join(workingDir, commandExportOutputPath))
+		err = os.WriteFile(outputPath, []byte(result.Content), 0o644)
+		if err != nil {
+			return err
+		}
+		fmt.Println("result successfully written to ", outputPath)
+	} else {
+		os.Stdout.WriteString(result.Content)
+	}
+	return nil
+}
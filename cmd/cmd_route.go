@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hiddify/hiddify-core/config"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commandRouteRequest config.RouteRequest
+	commandRouteJSON    bool
+)
+
+var commandRoute = &cobra.Command{
+	Use:   "route",
+	Short: "Inspect routing",
+}
+
+var commandRouteExplain = &cobra.Command{
+	Use:   "explain [domain or ip]",
+	Short: "Explain how a connection is routed",
+	Long: `Explain which dns server, route rule and outbound the config built from the
+settings uses for a connection. Nothing is resolved or downloaded: rule-sets
+are read from the rule-set directory and ip rules only match a given --ip.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := explainRoute(args)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandRouteExplain.Flags().StringVarP(&configPath, "config", "c", "", "proxy config path")
+	commandRouteExplain.MarkFlagRequired("config")
+	commandRouteExplain.Flags().StringVarP(&hiddifySettingPath, "hiddify", "d", "", "Hiddify Setting JSON Path")
+	commandRouteExplain.Flags().StringVar(&commandRouteRequest.Domain, "domain", "", "destination domain")
+	commandRouteExplain.Flags().StringVar(&commandRouteRequest.IP, "ip", "", "destination ip")
+	commandRouteExplain.Flags().Uint16VarP(&commandRouteRequest.Port, "port", "p", 443, "destination port")
+	commandRouteExplain.Flags().StringVarP(&commandRouteRequest.Network, "network", "n", "tcp", "network: tcp or udp")
+	commandRouteExplain.Flags().StringVar(&commandRouteRequest.Protocol, "protocol", "", "sniffed protocol, like tls or quic")
+	commandRouteExplain.Flags().StringVar(&commandRouteRequest.Inbound, "inbound", config.InboundMixedTag, "inbound tag")
+	commandRouteExplain.Flags().StringVar(&commandRouteRequest.SourceIP, "source-ip", "", "source ip (default loopback)")
+	commandRouteExplain.Flags().StringVar(&commandRouteRequest.Process, "process", "", "process name or path")
+	commandRouteExplain.Flags().StringVar(&commandRouteRequest.PackageName, "package", "", "android package name")
+	commandRouteExplain.Flags().BoolVar(&commandRouteJSON, "json", false, "print json")
+
+	commandRoute.AddCommand(commandRouteExplain)
+	mainCommand.AddCommand(commandRoute)
+}
+
+func explainRoute(args []string) error {
+	request := commandRouteRequest
+	if len(args) == 1 {
+		if addr, err := netip.ParseAddr(strings.Trim(args[0], "[]")); err == nil {
+			request.IP = addr.String()
+		} else {
+			request.Domain = args[0]
+		}
+	}
+	path, optionsPath := configPath, hiddifySettingPath
+	if workingDir != "" {
+		path = filepath.Join(workingDir, path)
+		if optionsPath != "" {
+			optionsPath = filepath.Join(workingDir, optionsPath)
+		}
+		os.Chdir(workingDir)
+	}
+	content, err := config.ParseConfig(path, false)
+	if err != nil {
+		return err
+	}
+	input, err := readConfigBytes(content)
+	if err != nil {
+		return err
+	}
+	opt := &defaultConfigs
+	if optionsPath != "" {
+		opt, err = readHiddifyOptionsAt(optionsPath)
+		if err != nil {
+			return err
+		}
+	}
+	explanation, err := config.ExplainRoute(*opt, *input, request)
+	if err != nil {
+		return err
+	}
+	if commandRouteJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(explanation)
+	}
+	if explanation.DNSServer != "" {
+		fmt.Printf("dns server:\t%s\t%s\n", explanation.DNSServer, describeMatchedRule(explanation.DNSRule))
+	}
+	fmt.Printf("route rule:\t%s\n", describeMatchedRule(explanation.RouteRule))
+	if explanation.RouteRule != nil {
+		fmt.Printf("\t\t%s\n", explanation.RouteRule.Rule)
+	}
+	outbound := strings.Join(explanation.OutboundChain, " -> ")
+	if explanation.OutboundType != "" {
+		outbound += " (" + explanation.OutboundType + ")"
+	}
+	fmt.Printf("outbound:\t%s\n", outbound)
+	for _, warning := range explanation.Warnings {
+		fmt.Printf("warning:\t%s\n", warning)
+	}
+	return nil
+}
+
+func describeMatchedRule(rule *config.MatchedRule) string {
+	if rule == nil {
+		return "final"
+	}
+	description := fmt.Sprintf("#%d %s", rule.Index, rule.Origin.Kind)
+	if rule.Origin.Rule != nil {
+		description += fmt.Sprintf(" %d", rule.Origin.Index)
+	}
+	if rule.Origin.Description != "" {
+		description += ": " + rule.Origin.Description
+	}
+	return description
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hiddify/hiddify-core/config"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commandRuleSetName        string
+	commandRuleSetInputFormat string
+	commandRuleSetFormat      string
+	commandRuleSetOutputPath  string
+	commandRuleSetDir         string
+	commandRuleSetVerbose     bool
+)
+
+var commandRuleSet = &cobra.Command{
+	Use:   "ruleset",
+	Short: "Manage local rule-sets",
+}
+
+var commandRuleSetCompile = &cobra.Command{
+	Use:   "compile <list>...",
+	Short: "Compile domain lists, hosts files, adblock filters or CIDR lists to a rule-set",
+	Long: `Compile domain lists, hosts files, adblock filters or CIDR lists, given as
+files, urls or - for stdin, into one rule-set. Without --output the result is
+saved in the local rule-sets and its path can be used as a rule's rule-set url.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := compileRuleSet(args)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+var commandRuleSetList = &cobra.Command{
+	Use:   "list",
+	Short: "List local rule-sets",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := listLocalRuleSets()
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandRuleSetCompile.Flags().StringVarP(&commandRuleSetName, "name", "n", "", "name of the local rule-set")
+	commandRuleSetCompile.Flags().StringVarP(&commandRuleSetInputFormat, "input-format", "i", string(config.RuleSetListAuto), "list format: auto, domains, hosts, adblock or cidr")
+	commandRuleSetCompile.Flags().StringVarP(&commandRuleSetFormat, "format", "f", C.RuleSetFormatBinary, "rule-set format: binary or source")
+	commandRuleSetCompile.Flags().StringVarP(&commandRuleSetOutputPath, "output", "o", "", "write result to file path instead of the local rule-sets")
+	commandRuleSetCompile.Flags().BoolVarP(&commandRuleSetVerbose, "verbose", "v", false, "print skipped entries")
+	commandRuleSet.PersistentFlags().StringVar(&commandRuleSetDir, "rule-set-dir", defaultConfigs.RuleSetDir, "rule-set directory")
+
+	commandRuleSet.AddCommand(commandRuleSetCompile)
+	commandRuleSet.AddCommand(commandRuleSetList)
+	mainCommand.AddCommand(commandRuleSet)
+}
+
+func compileRuleSet(inputs []string) error {
+	if commandRuleSetOutputPath == "" && commandRuleSetName == "" {
+		return fmt.Errorf("either --name or --output is required")
+	}
+	var list config.RuleSetList
+	for _, input := range inputs {
+		content, err := readRuleSetList(input)
+		if err != nil {
+			return err
+		}
+		if err := list.Parse(content, config.RuleSetListFormat(commandRuleSetInputFormat)); err != nil {
+			return fmt.Errorf("%s: %w", input, err)
+		}
+	}
+	content, err := list.Compile(commandRuleSetFormat)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d entries, %d skipped\n", list.Lines-len(list.Skipped), len(list.Skipped))
+	if commandRuleSetVerbose {
+		for _, line := range list.Skipped {
+			fmt.Fprintln(os.Stderr, "skipped", line)
+		}
+	}
+
+	if commandRuleSetOutputPath != "" {
+		outputPath, _ := filepath.Abs(commandRuleSetOutputPath)
+		err = os.WriteFile(outputPath, content, 0o644)
+		if err != nil {
+			return err
+		}
+		fmt.Println("result successfully written to ", outputPath)
+		return nil
+	}
+	opt := config.DefaultHiddifyOptions()
+	opt.RuleSetDir = commandRuleSetDir
+	path, err := config.SaveLocalRuleSet(opt, commandRuleSetName, commandRuleSetFormat, content)
+	if err != nil {
+		return err
+	}
+	fmt.Println("rule-set saved, use it as rule-set url:", path)
+	return nil
+}
+
+func readRuleSetList(input string) (string, error) {
+	switch {
+	case input == "-":
+		content, err := io.ReadAll(os.Stdin)
+		return string(content), err
+	case strings.HasPrefix(input, "http://") || strings.HasPrefix(input, "https://"):
+		response, err := http.Get(input)
+		if err != nil {
+			return "", err
+		}
+		defer response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("%s: unexpected status %s", input, response.Status)
+		}
+		content, err := io.ReadAll(response.Body)
+		return string(content), err
+	default:
+		content, err := os.ReadFile(input)
+		return string(content), err
+	}
+}
+
+func listLocalRuleSets() error {
+	opt := config.DefaultHiddifyOptions()
+	opt.RuleSetDir = commandRuleSetDir
+	sources, err := config.LocalRuleSets(opt)
+	if err != nil {
+		return err
+	}
+	for _, source := range sources {
+		fmt.Printf("%s\t%s\t%s\n", source.Tag, source.Format, source.URL)
+	}
+	return nil
+}
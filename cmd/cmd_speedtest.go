@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hiddify/hiddify-core/config"
+	v2 "github.com/hiddify/hiddify-core/v2"
+	"github.com/hiddify/hiddify-core/v2/servertest"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commandSpeedTestOptions    servertest.SpeedOptions
+	commandSpeedTestTag        string
+	commandSpeedTestNoDownload bool
+	commandSpeedTestNoUpload   bool
+	commandSpeedTestJSON       bool
+)
+
+var commandSpeedTest = &cobra.Command{
+	Use:   "speedtest",
+	Short: "Measure latency, jitter and throughput of a config",
+	Long: `Measure latency, jitter and the download and upload throughput through an
+isolated instance of a config, or one of its outbounds with --tag.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := speedTest()
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandSpeedTest.Flags().StringVarP(&configPath, "config", "c", "", "proxy config path or url")
+	commandSpeedTest.MarkFlagRequired("config")
+	commandSpeedTest.Flags().StringVarP(&hiddifySettingPath, "hiddify", "d", "", "Hiddify Setting JSON Path")
+	commandSpeedTest.Flags().StringVarP(&commandSpeedTestTag, "tag", "t", "", "outbound to test (default outbound if empty)")
+	commandSpeedTest.Flags().StringVar(&commandSpeedTestOptions.URL, "url", servertest.DefaultURL, "url for latency and jitter")
+	commandSpeedTest.Flags().IntVar(&commandSpeedTestOptions.Pings, "pings", servertest.DefaultSpeedPings, "requests for latency and jitter")
+	commandSpeedTest.Flags().StringVar(&commandSpeedTestOptions.DownloadURL, "download-url", servertest.DefaultSpeedDownloadURL, "url to download from")
+	commandSpeedTest.Flags().StringVar(&commandSpeedTestOptions.UploadURL, "upload-url", servertest.DefaultSpeedUploadURL, "url to upload to")
+	commandSpeedTest.Flags().DurationVar(&commandSpeedTestOptions.Duration, "duration", servertest.DefaultSpeedDuration, "duration of each direction")
+	commandSpeedTest.Flags().IntVar(&commandSpeedTestOptions.Streams, "streams", servertest.DefaultSpeedStreams, "parallel connections")
+	commandSpeedTest.Flags().BoolVar(&commandSpeedTestNoDownload, "no-download", false, "skip the download")
+	commandSpeedTest.Flags().BoolVar(&commandSpeedTestNoUpload, "no-upload", false, "skip the upload")
+	commandSpeedTest.Flags().BoolVar(&commandSpeedTestJSON, "json", false, "print json")
+
+	mainCommand.AddCommand(commandSpeedTest)
+}
+
+func speedTest() error {
+	settings := defaultConfigs
+	if hiddifySettingPath != "" {
+		opt, err := readHiddifyOptionsAt(hiddifySettingPath)
+		if err != nil {
+			return err
+		}
+		settings = *opt
+	}
+	content, err := v2.ReadConfigContent(configPath)
+	if err != nil {
+		return err
+	}
+	options, err := config.ParseConfigContentToOptions(content.Config, false, &settings, false)
+	if err != nil {
+		return err
+	}
+	instance, err := v2.RunEmbeddedInstance(&settings, options)
+	if err != nil {
+		return err
+	}
+	defer instance.Close()
+
+	speedOptions := commandSpeedTestOptions
+	if commandSpeedTestNoDownload {
+		speedOptions.DownloadURL = ""
+	}
+	if commandSpeedTestNoUpload {
+		speedOptions.UploadURL = ""
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	result, err := instance.Dialer(commandSpeedTestTag).SpeedTest(ctx, speedOptions)
+	if err != nil {
+		return err
+	}
+
+	if commandSpeedTestJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+	fmt.Printf("latency:\t%s\n", formatTestDuration(result.Latency))
+	fmt.Printf("jitter:\t\t%s\n", formatTestDuration(result.Jitter))
+	if speedOptions.DownloadURL != "" {
+		fmt.Printf("download:\t%.2f Mbps (%d bytes)\n", result.DownloadMbps, result.Downloaded)
+	}
+	if speedOptions.UploadURL != "" {
+		fmt.Printf("upload:\t\t%.2f Mbps (%d bytes)\n", result.UploadMbps, result.Uploaded)
+	}
+	return nil
+}
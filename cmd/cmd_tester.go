@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	v2 "github.com/hiddify/hiddify-core/v2"
+	"github.com/hiddify/hiddify-core/v2/servertest"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commandTestOptions servertest.Options
+	commandTestTags    []string
+	commandTestJSON    bool
+)
+
+var commandTest = &cobra.Command{
+	Use:   "test",
+	Short: "Test every server of a config",
+	Long: `Test every outbound of a config or subscription through one isolated
+instance, without a local proxy, and print them from the fastest to the
+failed ones.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := testServers()
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandTest.Flags().StringVarP(&configPath, "config", "c", "", "proxy config path or url")
+	commandTest.MarkFlagRequired("config")
+	commandTest.Flags().StringVarP(&hiddifySettingPath, "hiddify", "d", "", "Hiddify Setting JSON Path")
+	commandTest.Flags().StringSliceVarP(&commandTestTags, "tag", "t", nil, "only test these outbounds")
+	commandTest.Flags().StringVar(&commandTestOptions.URL, "url", servertest.DefaultURL, "url for connect, tls and http latency")
+	commandTest.Flags().StringVar(&commandTestOptions.DownloadURL, "download-url", servertest.DefaultDownloadURL, "url for throughput, empty to skip")
+	commandTest.Flags().Int64Var(&commandTestOptions.DownloadLimit, "download-size", servertest.DefaultDownloadLimit, "max bytes to download")
+	commandTest.Flags().DurationVar(&commandTestOptions.Timeout, "timeout", servertest.DefaultTimeout, "timeout per server")
+	commandTest.Flags().IntVarP(&commandTestOptions.Concurrency, "concurrency", "j", servertest.DefaultConcurrency, "servers tested at once")
+	commandTest.Flags().BoolVar(&commandTestJSON, "json", false, "print json")
+
+	mainCommand.AddCommand(commandTest)
+}
+
+func testServers() error {
+	// only the results go to stdout, so that the json can be piped
+	stdout, logOutput := os.Stdout, os.Stderr
+	settings := defaultConfigs
+	if hiddifySettingPath != "" {
+		opt, err := readHiddifyOptionsAt(hiddifySettingPath)
+		if err != nil {
+			return err
+		}
+		settings = *opt
+	}
+	content, err := v2.ReadConfigContent(configPath)
+	if err != nil {
+		return err
+	}
+	// warp devices are registered by the instance, logging to logOutput
+	options, err := config.ParseConfigContentToOptionsWithoutWarp(content.Config, &settings)
+	if err != nil {
+		return err
+	}
+
+	var targets []servertest.Target
+	wanted := map[string]bool{}
+	for _, tag := range commandTestTags {
+		wanted[tag] = true
+	}
+	for _, outbound := range options.Outbounds {
+		switch outbound.Type {
+		case C.TypeDirect, C.TypeBlock, C.TypeDNS, C.TypeSelector, C.TypeURLTest:
+			continue
+		}
+		if len(wanted) > 0 && !wanted[outbound.Tag] {
+			continue
+		}
+		targets = append(targets, servertest.Target{Tag: outbound.Tag, Type: outbound.Type})
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no servers to test")
+	}
+
+	instance, err := v2.RunEmbeddedInstanceWithLog(&settings, options, logOutput)
+	if err != nil {
+		return err
+	}
+	defer instance.Close()
+	for i := range targets {
+		targets[i].Dial = instance.Dialer(targets[i].Tag).DialContext
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	results := servertest.Run(ctx, targets, commandTestOptions)
+
+	if commandTestJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	}
+	writer := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "TAG\tTYPE\tCONNECT\tTLS\tLATENCY\tTOTAL\tSPEED\tERROR")
+	for _, result := range results {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			result.Tag, result.Type,
+			formatTestDuration(result.Connect), formatTestDuration(result.TLSHandshake), formatTestDuration(result.Latency),
+			formatTestDuration(result.Total()), formatTestSpeed(result.Throughput), strings.ReplaceAll(result.Error, "\n", " "))
+	}
+	return writer.Flush()
+}
+
+func formatTestDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return d.Round(time.Millisecond).String()
+}
+
+func formatTestSpeed(bytesPerSecond float64) string {
+	if bytesPerSecond == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f Mbps", bytesPerSecond*8/1_000_000)
+}
@@ -7,8 +7,12 @@ import (
 
 	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/hiddify/hiddify-core/config"
+	"github.com/hiddify/hiddify-core/v2/db"
+	"github.com/sagernet/sing-box/log"
 	T "github.com/sagernet/sing-box/option"
 	"github.com/spf13/cobra"
 )
@@ -28,11 +32,122 @@ var commandWarp = &cobra.Command{
 	},
 }
 
+var (
+	commandWarpName       string
+	commandWarpLicense    string
+	commandWarpAccountID  string
+	commandWarpToken      string
+	commandWarpPrivateKey string
+	commandWarpKeepDevice bool
+)
+
+var commandWarpList = &cobra.Command{
+	Use:   "list",
+	Short: "List the accounts of the warp vault",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		entries, err := config.ListWarpVault()
+		if err != nil {
+			log.Fatal(err)
+		}
+		printWarpVault(entries...)
+	},
+}
+
+var commandWarpCreate = &cobra.Command{
+	Use:   "create",
+	Short: "Register a new warp account in the vault",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		entry, err := config.CreateWarpVaultEntry(commandWarpName, commandWarpLicense)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printWarpVault(entry)
+	},
+}
+
+var commandWarpImport = &cobra.Command{
+	Use:   "import",
+	Short: "Import an existing warp account into the vault",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		entry, err := config.ImportWarpVaultEntry(commandWarpName, commandWarpAccountID, commandWarpToken, commandWarpPrivateKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printWarpVault(entry)
+	},
+}
+
+var commandWarpBind = &cobra.Command{
+	Use:   "bind <id> <license>",
+	Short: "Bind a WARP+ license to a vault account",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		entry, err := config.BindWarpVaultLicense(args[0], args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		printWarpVault(entry)
+	},
+}
+
+var commandWarpRotate = &cobra.Command{
+	Use:   "rotate <id>",
+	Short: "Register a new key pair for a vault account",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		entry, err := config.RotateWarpVaultEntry(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+		printWarpVault(entry)
+	},
+}
+
+var commandWarpDelete = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a vault account and its warp device",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := config.DeleteWarpVaultEntry(args[0], commandWarpKeepDevice)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
 func init() {
 	// commandWarp.Flags().StringVarP(&warpKey, "key", "k", "", "warp key")
+	commandWarpCreate.Flags().StringVarP(&commandWarpName, "name", "n", "", "account name")
+	commandWarpCreate.Flags().StringVarP(&commandWarpLicense, "license", "l", "", "WARP+ license")
+	commandWarpImport.Flags().StringVarP(&commandWarpName, "name", "n", "", "account name")
+	commandWarpImport.Flags().StringVar(&commandWarpAccountID, "account-id", "", "device id of the account")
+	commandWarpImport.Flags().StringVar(&commandWarpToken, "access-token", "", "access token of the account")
+	commandWarpImport.Flags().StringVar(&commandWarpPrivateKey, "private-key", "", "wireguard private key (a new key pair is registered if empty)")
+	commandWarpImport.MarkFlagRequired("account-id")
+	commandWarpImport.MarkFlagRequired("access-token")
+	commandWarpDelete.Flags().BoolVar(&commandWarpKeepDevice, "keep-device", false, "only remove the account from the vault")
+
+	commandWarp.AddCommand(commandWarpList, commandWarpCreate, commandWarpImport, commandWarpBind, commandWarpRotate, commandWarpDelete)
 	mainCommand.AddCommand(commandWarp)
 }
 
+// printWarpVault prints vault accounts with the key outbounds use for them.
+func printWarpVault(entries ...*db.WarpVaultEntry) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tNAME\tKEY\tTYPE\tWARP+\tROTATED")
+	for _, entry := range entries {
+		rotated := "-"
+		if !entry.RotatedAt.IsZero() {
+			rotated = entry.RotatedAt.Format(time.DateTime)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%t\t%s\n", entry.Id, entry.Name, config.WarpVaultKey(entry.Id), entry.AccountType, entry.WarpPlus, rotated)
+	}
+	writer.Flush()
+}
+
 type WireGuardConfig struct {
 	Interface InterfaceConfig `json:"Interface"`
 	Peer      PeerConfig      `json:"Peer"`
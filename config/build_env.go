@@ -0,0 +1,297 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bepass-org/warp-plus/warp"
+	"github.com/hiddify/hiddify-core/v2/common"
+)
+
+// Prober answers the questions a build asks the network.
+type Prober interface {
+	LookupHost(host string) ([]string, error)
+	CanConnectIPv6() bool
+}
+
+// Random picks the random parts of a build: secrets, names and warp
+// endpoints.
+type Random interface {
+	String(length int) string
+	WarpEndpoint(ipv4 bool, ipv6 bool) (netip.AddrPort, error)
+	WarpPort() uint16
+}
+
+// WarpAccounts returns the wireguard configs of warp keys.
+type WarpAccounts interface {
+	// Saved returns the config of a saved key such as p1, registering a
+	// device for it when it has none yet. A key that points to the vault
+	// fails when its account is missing.
+	Saved(opt *WarpOptions) (WarpWireguardConfig, error)
+	// Vault returns the config of a vault account.
+	Vault(id string) (WarpWireguardConfig, error)
+	// Register registers a new device, with a WARP+ license if given.
+	Register(license string) (WarpWireguardConfig, error)
+}
+
+// Files tells which files exist, such as the rule-sets cached in
+// RuleSetDir.
+type Files interface {
+	Exists(path string) bool
+}
+
+// BuildEnv is everything a build takes from outside of its options; builds
+// with equal options and env produce equal configs.
+type BuildEnv struct {
+	Prober Prober
+	Random Random
+	Warp   WarpAccounts
+	Files  Files
+}
+
+var defaultProber = NewCachedProber(netProber{}, 10*time.Minute)
+
+// DefaultBuildEnv probes the network through a shared cache, picks random
+// values, registers warp devices and reads the file system.
+func DefaultBuildEnv() BuildEnv {
+	return BuildEnv{Prober: defaultProber, Random: randomValues{}, Warp: storedWarpAccounts{}, Files: osFiles{}}
+}
+
+// build is the state of a single build.
+type build struct {
+	BuildEnv
+	// mainProxyTag is the outbound of proxy rules and the final outbound.
+	mainProxyTag string
+	// origins records where the route and dns rules come from, if set.
+	origins *ruleOrigins
+}
+
+func newBuild(env BuildEnv) *build {
+	defaults := DefaultBuildEnv()
+	if env.Prober == nil {
+		env.Prober = defaults.Prober
+	}
+	if env.Random == nil {
+		env.Random = defaults.Random
+	}
+	if env.Warp == nil {
+		env.Warp = defaults.Warp
+	}
+	if env.Files == nil {
+		env.Files = defaults.Files
+	}
+	return &build{BuildEnv: env, mainProxyTag: OutboundSelectTag}
+}
+
+type netProber struct{}
+
+func (netProber) LookupHost(host string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return net.DefaultResolver.LookupHost(ctx, host)
+}
+
+func (netProber) CanConnectIPv6() bool {
+	return common.CanConnectIPv6()
+}
+
+type probedHost struct {
+	addrs   []string
+	err     error
+	expires time.Time
+}
+
+// CachedProber remembers the answers of a prober for ttl. It is safe for
+// concurrent builds.
+type CachedProber struct {
+	prober Prober
+	ttl    time.Duration
+
+	access      sync.Mutex
+	hosts       map[string]probedHost
+	ipv6        bool
+	ipv6Expires time.Time
+}
+
+func NewCachedProber(prober Prober, ttl time.Duration) *CachedProber {
+	return &CachedProber{prober: prober, ttl: ttl, hosts: map[string]probedHost{}}
+}
+
+func (p *CachedProber) LookupHost(host string) ([]string, error) {
+	p.access.Lock()
+	cached, ok := p.hosts[host]
+	p.access.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.addrs, cached.err
+	}
+	addrs, err := p.prober.LookupHost(host)
+	p.access.Lock()
+	p.hosts[host] = probedHost{addrs: addrs, err: err, expires: time.Now().Add(p.ttl)}
+	p.access.Unlock()
+	return addrs, err
+}
+
+func (p *CachedProber) CanConnectIPv6() bool {
+	p.access.Lock()
+	cached, expires := p.ipv6, p.ipv6Expires
+	p.access.Unlock()
+	if time.Now().Before(expires) {
+		return cached
+	}
+	ipv6 := p.prober.CanConnectIPv6()
+	p.access.Lock()
+	p.ipv6, p.ipv6Expires = ipv6, time.Now().Add(p.ttl)
+	p.access.Unlock()
+	return ipv6
+}
+
+// StaticProber answers from fixed results, for offline and repeatable builds.
+// Hosts it does not know fail to resolve.
+type StaticProber struct {
+	Hosts map[string][]string
+	IPv6  bool
+}
+
+func (p StaticProber) LookupHost(host string) ([]string, error) {
+	addrs, ok := p.Hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func (p StaticProber) CanConnectIPv6() bool {
+	return p.IPv6
+}
+
+type storedWarpAccounts struct {
+	log io.Writer
+}
+
+// NewWarpAccounts returns the accounts of DefaultBuildEnv, logging device
+// registrations to log instead of stdout.
+func NewWarpAccounts(log io.Writer) WarpAccounts {
+	return storedWarpAccounts{log: log}
+}
+
+func (a storedWarpAccounts) logOutput() io.Writer {
+	if a.log == nil {
+		return os.Stdout
+	}
+	return a.log
+}
+
+func (a storedWarpAccounts) Saved(opt *WarpOptions) (WarpWireguardConfig, error) {
+	return getOrGenerateWarpLocallyIfNeeded(opt, a.logOutput())
+}
+
+func (storedWarpAccounts) Vault(id string) (WarpWireguardConfig, error) {
+	return WarpVaultConfig(id)
+}
+
+func (a storedWarpAccounts) Register(license string) (WarpWireguardConfig, error) {
+	_, _, wgConfig, err := generateWarpInfo(a.logOutput(), license, "", "")
+	if err != nil {
+		return WarpWireguardConfig{}, err
+	}
+	return *wgConfig, nil
+}
+
+// StaticWarpAccounts gives every warp key the same config, for offline and
+// repeatable builds.
+type StaticWarpAccounts struct {
+	Config WarpWireguardConfig
+}
+
+func (a StaticWarpAccounts) Saved(*WarpOptions) (WarpWireguardConfig, error) {
+	return a.Config, nil
+}
+
+func (a StaticWarpAccounts) Vault(string) (WarpWireguardConfig, error) {
+	return a.Config, nil
+}
+
+func (a StaticWarpAccounts) Register(string) (WarpWireguardConfig, error) {
+	return a.Config, nil
+}
+
+type osFiles struct{}
+
+func (osFiles) Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// StaticFiles lists the files that exist, for repeatable builds.
+type StaticFiles map[string]bool
+
+func (f StaticFiles) Exists(path string) bool {
+	return f[path]
+}
+
+type randomValues struct{}
+
+func (randomValues) String(length int) string {
+	return generateRandomString(length)
+}
+
+func (randomValues) WarpEndpoint(ipv4 bool, ipv6 bool) (netip.AddrPort, error) {
+	return warp.RandomWarpEndpoint(ipv4, ipv6)
+}
+
+func (randomValues) WarpPort() uint16 {
+	return warp.RandomWarpPort()
+}
+
+// SeededRandom picks the same values for the same seed, for repeatable
+// builds; it must not be shared by concurrent builds. Warp endpoints are
+// taken from the default cloudflare ranges.
+type SeededRandom struct {
+	rand *rand.Rand
+}
+
+func NewSeededRandom(seed int64) *SeededRandom {
+	return &SeededRandom{rand: rand.New(rand.NewSource(seed))}
+}
+
+func (r *SeededRandom) String(length int) string {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+	value := make([]byte, length)
+	for i := range value {
+		value[i] = letters[r.rand.Intn(len(letters))]
+	}
+	return string(value)
+}
+
+var seededWarpPorts = []uint16{500, 854, 859, 864, 878, 880, 890, 891, 894, 903, 908, 928, 934, 939, 942, 943, 945, 946, 955, 968, 987, 988, 1002, 1010, 1014, 1018, 1070, 1074, 1180, 1387, 1701, 1843, 2371, 2408, 2506, 3138, 3476, 3581, 3854, 4177, 4198, 4233, 4500, 5279, 5956, 7103, 7152, 7156, 7281, 7559, 8319, 8742, 8854, 8886}
+
+func (r *SeededRandom) WarpEndpoint(ipv4 bool, ipv6 bool) (netip.AddrPort, error) {
+	var prefixes []netip.Prefix
+	if ipv4 {
+		prefixes = append(prefixes, netip.MustParsePrefix("162.159.192.0/24"), netip.MustParsePrefix("188.114.96.0/24"))
+	}
+	if ipv6 {
+		prefixes = append(prefixes, netip.MustParsePrefix("2606:4700:d0::/64"), netip.MustParsePrefix("2606:4700:d1::/64"))
+	}
+	if len(prefixes) == 0 {
+		return netip.AddrPort{}, fmt.Errorf("no ip family for the warp endpoint")
+	}
+	prefix := prefixes[r.rand.Intn(len(prefixes))]
+	addr := prefix.Addr().AsSlice()
+	for i := prefix.Bits() / 8; i < len(addr); i++ {
+		addr[i] = byte(r.rand.Intn(256))
+	}
+	ip, _ := netip.AddrFromSlice(addr)
+	return netip.AddrPortFrom(ip, r.WarpPort()), nil
+}
+
+func (r *SeededRandom) WarpPort() uint16 {
+	return seededWarpPorts[r.rand.Intn(len(seededWarpPorts))]
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+type countingProber struct {
+	StaticProber
+	lookups int
+}
+
+func (p *countingProber) LookupHost(host string) ([]string, error) {
+	p.lookups++
+	return p.StaticProber.LookupHost(host)
+}
+
+func TestCachedProber(t *testing.T) {
+	counting := &countingProber{StaticProber: StaticProber{Hosts: map[string][]string{"example.com": {"192.0.2.1"}}}}
+	prober := NewCachedProber(counting, time.Minute)
+	for i := 0; i < 3; i++ {
+		if addrs, err := prober.LookupHost("example.com"); err != nil || addrs[0] != "192.0.2.1" {
+			t.Fatalf("unexpected lookup: %v %v", addrs, err)
+		}
+		if _, err := prober.LookupHost("missing.example"); err == nil {
+			t.Fatal("expected error for unknown host")
+		}
+	}
+	if counting.lookups != 2 {
+		t.Errorf("expected 2 lookups, got %d", counting.lookups)
+	}
+}
+
+func TestSeededRandom(t *testing.T) {
+	a, b := NewSeededRandom(7), NewSeededRandom(7)
+	if a.String(20) != b.String(20) {
+		t.Error("equal seeds gave different strings")
+	}
+	endpoint, err := a.WarpEndpoint(true, false)
+	if err != nil || !endpoint.Addr().Is4() || endpoint.Port() == 0 {
+		t.Errorf("unexpected endpoint: %v %v", endpoint, err)
+	}
+	if other, _ := b.WarpEndpoint(true, false); other != endpoint {
+		t.Errorf("equal seeds gave different endpoints: %v %v", endpoint, other)
+	}
+}
+
+func TestBuildConfigIsRepeatable(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	opt.EnableClashApi = true
+	opt.ClashApiSecret = ""
+	socks := func(tag string, server string) option.Outbound {
+		return option.Outbound{
+			Type: C.TypeSOCKS,
+			Tag:  tag,
+			SocksOptions: option.SocksOutboundOptions{
+				ServerOptions: option.ServerOptions{Server: server, ServerPort: 1080},
+			},
+		}
+	}
+	// servers with domains are resolved directly, in one dns rule
+	input := option.Options{
+		Outbounds: []option.Outbound{
+			socks("socks", "127.0.0.1"),
+			socks("a", "a.example.com"),
+			socks("b", "b.example.com"),
+			socks("c", "c.example.com"),
+			socks("d", "d.example.com"),
+			{
+				Type:          C.TypeCustom,
+				Tag:           "warp",
+				CustomOptions: map[string]interface{}{"warp": map[string]interface{}{"key": "p1", "host": "auto"}},
+			},
+		},
+	}
+	prober := StaticProber{Hosts: map[string][]string{"sky.rethinkdns.com": {"192.0.2.2"}}}
+	env := func() BuildEnv {
+		return BuildEnv{
+			Prober: prober,
+			Random: NewSeededRandom(1),
+			Warp:   StaticWarpAccounts{Config: WarpWireguardConfig{LocalAddressIPv4: "172.16.0.2", LocalAddressIPv6: "fd01::2"}},
+			Files:  StaticFiles{},
+		}
+	}
+	results := make([][]byte, 4)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			options, err := BuildConfigWithEnv(*opt, input, env())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			results[i], _ = json.Marshal(options)
+		}(i)
+	}
+	wg.Wait()
+	for i := range results {
+		if string(results[i]) != string(results[0]) {
+			t.Fatalf("build %d differs:\n%s\n%s", i, results[i], results[0])
+		}
+	}
+	if opt.ClashApiSecret != "" {
+		t.Error("build changed the options")
+	}
+}
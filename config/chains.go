@@ -0,0 +1,146 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+const chainHopMark = "§hop§"
+
+// OutboundChain sends traffic through Hops in order, from the entry to the
+// exit. Hops are outbound tags from any subscription or group tags; the entry
+// is used as is while every later hop is a copy dialing through the hop
+// before it. A group after the entry becomes a url-test group over such
+// copies of its members.
+type OutboundChain struct {
+	Tag  string   `json:"tag"`
+	Hops []string `json:"hops"`
+}
+
+// withDetour returns out dialing through detour. The detour of an outbound
+// that already has one is only replaced when override is set.
+func withDetour(out option.Outbound, detour string, override bool) (option.Outbound, error) {
+	content, err := out.MarshalJSON()
+	if err != nil {
+		return out, err
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(content, &obj); err != nil {
+		return out, err
+	}
+	if current, _ := obj["detour"].(string); current != "" && !override {
+		return out, nil
+	}
+	obj["detour"] = detour
+	content, err = json.Marshal(obj)
+	if err != nil {
+		return out, err
+	}
+	var patched option.Outbound
+	if err := patched.UnmarshalJSON(content); err != nil {
+		return out, err
+	}
+	return patched, nil
+}
+
+// makeChainOutbounds builds the outbounds of the chains; the last hop of each
+// chain carries the chain tag. groups maps the tag of every group that can
+// be used as a hop to its members.
+func makeChainOutbounds(chains []OutboundChain, proxies []option.Outbound, groups map[string][]string, opt *HiddifyOptions) ([]option.Outbound, error) {
+	byTag := make(map[string]option.Outbound, len(proxies))
+	for _, proxy := range proxies {
+		byTag[proxy.Tag] = proxy
+	}
+	hopCopy := func(chain string, hop string, tag string, detour string) (option.Outbound, error) {
+		proxy, ok := byTag[hop]
+		if !ok {
+			return option.Outbound{}, fmt.Errorf("chain %s: unknown hop %s", chain, hop)
+		}
+		proxy.Tag = tag
+		patched, err := withDetour(proxy, detour, true)
+		if err != nil {
+			return option.Outbound{}, fmt.Errorf("chain %s: hop %s can not dial through %s: %w", chain, hop, detour, err)
+		}
+		return patched, nil
+	}
+
+	used := map[string]bool{}
+	var outbounds []option.Outbound
+	for _, chain := range chains {
+		if chain.Tag == "" {
+			return nil, fmt.Errorf("chain without tag")
+		}
+		_, isProxy := byTag[chain.Tag]
+		_, isGroup := groups[chain.Tag]
+		if isProxy || isGroup || used[chain.Tag] || reservedOutboundTags[chain.Tag] {
+			return nil, fmt.Errorf("chain %s: tag already in use", chain.Tag)
+		}
+		used[chain.Tag] = true
+		if len(chain.Hops) < 2 {
+			return nil, fmt.Errorf("chain %s: needs at least two hops", chain.Tag)
+		}
+		entry := chain.Hops[0]
+		if entry == OutboundSelectTag {
+			return nil, fmt.Errorf("chain %s: the %s group can not be a hop", chain.Tag, OutboundSelectTag)
+		}
+		if _, ok := byTag[entry]; !ok {
+			if _, ok := groups[entry]; !ok {
+				return nil, fmt.Errorf("chain %s: unknown hop %s", chain.Tag, entry)
+			}
+		}
+
+		detour := entry
+		for i, hop := range chain.Hops[1:] {
+			tag := fmt.Sprintf("%s%s%d", chain.Tag, chainHopMark, i+1)
+			if i+2 == len(chain.Hops) {
+				tag = chain.Tag
+			}
+			if _, ok := byTag[hop]; ok {
+				hopOutbound, err := hopCopy(chain.Tag, hop, tag, detour)
+				if err != nil {
+					return nil, err
+				}
+				outbounds = append(outbounds, hopOutbound)
+				detour = tag
+				continue
+			}
+			members, ok := groups[hop]
+			if !ok {
+				return nil, fmt.Errorf("chain %s: unknown hop %s", chain.Tag, hop)
+			}
+			var memberTags []string
+			for _, member := range members {
+				if _, ok := byTag[member]; !ok {
+					// nested groups and block placeholders can not be copied
+					continue
+				}
+				hopOutbound, err := hopCopy(chain.Tag, member, tag+chainHopMark+member, detour)
+				if err != nil {
+					return nil, err
+				}
+				outbounds = append(outbounds, hopOutbound)
+				memberTags = append(memberTags, hopOutbound.Tag)
+			}
+			if len(memberTags) == 0 {
+				return nil, fmt.Errorf("chain %s: group %s has no outbound to chain", chain.Tag, hop)
+			}
+			outbounds = append(outbounds, option.Outbound{
+				Type: C.TypeURLTest,
+				Tag:  tag,
+				URLTestOptions: option.URLTestOutboundOptions{
+					Outbounds:                 memberTags,
+					URL:                       opt.ConnectionTestUrl,
+					Interval:                  option.Duration(opt.URLTestInterval.Duration()),
+					Tolerance:                 1,
+					IdleTimeout:               option.Duration(opt.URLTestInterval.Duration().Nanoseconds() * 3),
+					InterruptExistConnections: true,
+				},
+			})
+			detour = tag
+		}
+	}
+	return outbounds, nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+var chainTestProxies = []option.Outbound{
+	{Type: C.TypeVLESS, Tag: "entry", VLESSOptions: option.VLESSOutboundOptions{ServerOptions: option.ServerOptions{Server: "entry.example.com", ServerPort: 443}}},
+	{Type: C.TypeShadowsocks, Tag: "exit", ShadowsocksOptions: option.ShadowsocksOutboundOptions{ServerOptions: option.ServerOptions{Server: "exit.example.com", ServerPort: 8388}, Method: "aes-128-gcm", Password: "pass"}},
+	{Type: C.TypeTrojan, Tag: "exit2", TrojanOptions: option.TrojanOutboundOptions{ServerOptions: option.ServerOptions{Server: "exit2.example.com", ServerPort: 443}, Password: "pass"}},
+}
+
+func TestMakeChainOutbounds(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	groups := map[string][]string{"exits": {"exit", "exit2", OutboundBlockTag}}
+	chains := []OutboundChain{
+		{Tag: "double", Hops: []string{"entry", "exit"}},
+		{Tag: "triple", Hops: []string{"exits", "entry", "exits"}},
+	}
+	outbounds, err := makeChainOutbounds(chains, chainTestProxies, groups, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byTag := map[string]option.Outbound{}
+	for _, outbound := range outbounds {
+		byTag[outbound.Tag] = outbound
+	}
+	if len(outbounds) != 5 {
+		t.Fatalf("unexpected outbounds: %+v", outbounds)
+	}
+
+	double := byTag["double"]
+	if double.Type != C.TypeShadowsocks || double.ShadowsocksOptions.Detour != "entry" || double.ShadowsocksOptions.Server != "exit.example.com" {
+		t.Errorf("unexpected exit hop: %+v", double)
+	}
+	middle := byTag["triple"+chainHopMark+"1"]
+	if middle.Type != C.TypeVLESS || middle.VLESSOptions.Detour != "exits" {
+		t.Errorf("unexpected middle hop: %+v", middle)
+	}
+	exit := byTag["triple"]
+	if exit.Type != C.TypeURLTest || len(exit.URLTestOptions.Outbounds) != 2 {
+		t.Fatalf("unexpected exit group: %+v", exit)
+	}
+	if copy := byTag[exit.URLTestOptions.Outbounds[1]]; copy.TrojanOptions.Detour != middle.Tag {
+		t.Errorf("exit group member should dial through the middle hop: %+v", copy)
+	}
+	if chainTestProxies[1].ShadowsocksOptions.Detour != "" {
+		t.Error("chains must not change the original outbounds")
+	}
+}
+
+func TestMakeChainOutboundsErrors(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	for _, chains := range [][]OutboundChain{
+		{{Hops: []string{"entry", "exit"}}},
+		{{Tag: "exit", Hops: []string{"entry", "exit"}}},
+		{{Tag: "single", Hops: []string{"exit"}}},
+		{{Tag: "loop", Hops: []string{OutboundSelectTag, "exit"}}},
+		{{Tag: "missing", Hops: []string{"entry", "nowhere"}}},
+		{{Tag: "twice", Hops: []string{"entry", "exit"}}, {Tag: "twice", Hops: []string{"entry", "exit2"}}},
+	} {
+		if _, err := makeChainOutbounds(chains, chainTestProxies, nil, opt); err == nil {
+			t.Errorf("expected error for %+v", chains)
+		}
+	}
+}
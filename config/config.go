@@ -10,8 +10,9 @@ import (
 	"net/netip"
 	"net/url"
 	"runtime"
+	"slices"
+	"sort"
 	"strings"
-	"time"
 
 	C "github.com/sagernet/sing-box/constant"
 	"github.com/sagernet/sing-box/option"
@@ -39,7 +40,8 @@ const (
 	InboundDNSTag   = "dns-in"
 )
 
-var OutboundMainProxyTag = OutboundSelectTag
+// OutboundWarpTag is the warp outbound of the warp settings.
+const OutboundWarpTag = "Hiddify Warp ✅"
 
 func BuildConfigJson(configOpt HiddifyOptions, input option.Options) (string, error) {
 	options, err := BuildConfig(configOpt, input)
@@ -59,8 +61,16 @@ func BuildConfigJson(configOpt HiddifyOptions, input option.Options) (string, er
 
 // TODO include selectors
 func BuildConfig(opt HiddifyOptions, input option.Options) (*option.Options, error) {
-	fmt.Printf("config options: %++v\n", opt)
+	return BuildConfigWithEnv(opt, input, DefaultBuildEnv())
+}
+
+// BuildConfigWithEnv builds the config without touching anything but env,
+// so that builds can run concurrently and equal inputs give equal configs.
+func BuildConfigWithEnv(opt HiddifyOptions, input option.Options, env BuildEnv) (*option.Options, error) {
+	return buildConfig(opt, input, newBuild(env))
+}
 
+func buildConfig(opt HiddifyOptions, input option.Options, b *build) (*option.Options, error) {
 	var options option.Options
 	if opt.EnableFullConfig {
 		options.Inbounds = input.Inbounds
@@ -68,13 +78,20 @@ func BuildConfig(opt HiddifyOptions, input option.Options) (*option.Options, err
 		options.Route = input.Route
 	}
 
-	setClashAPI(&options, &opt)
+	if err := validateTransparentProxy(&opt); err != nil {
+		return nil, err
+	}
+	if err := validateLANShare(&opt); err != nil {
+		return nil, err
+	}
+	setWarp(&opt, &input, b)
+	setClashAPI(&options, &opt, b)
 	setLog(&options, &opt)
 	setInbound(&options, &opt)
-	setDns(&options, &opt)
-	setRoutingOptions(&options, &opt)
-	setFakeDns(&options, &opt)
-	err := setOutbounds(&options, &input, &opt)
+	setDns(&options, &opt, b)
+	setRoutingOptions(&options, &opt, b)
+	setFakeDns(&options, &opt, b)
+	err := setOutbounds(&options, &input, &opt, b)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +99,7 @@ func BuildConfig(opt HiddifyOptions, input option.Options) (*option.Options, err
 	return &options, nil
 }
 
-func addForceDirect(options *option.Options, opt *HiddifyOptions, directDNSDomains map[string]bool) {
+func addForceDirect(options *option.Options, opt *HiddifyOptions, b *build, directDNSDomains map[string]bool) {
 	remoteDNSAddress := opt.RemoteDnsAddress
 	if strings.Contains(remoteDNSAddress, "://") {
 		remoteDNSAddress = strings.SplitAfter(remoteDNSAddress, "://")[1]
@@ -117,28 +134,28 @@ func addForceDirect(options *option.Options, opt *HiddifyOptions, directDNSDomai
 		for key := range directDNSDomains {
 			directDNSDomainskeys = append(directDNSDomainskeys, key)
 		}
+		sort.Strings(directDNSDomainskeys)
 
 		domains := strings.Join(directDNSDomainskeys, ",")
 		directRule := Rule{Domains: domains, Outbound: OutboundBypassTag}
 		dnsRule := directRule.MakeDNSRule()
 		dnsRule.Server = DNSDirectTag
 		options.DNS.Rules = append([]option.DNSRule{{Type: C.RuleTypeDefault, DefaultOptions: dnsRule}}, options.DNS.Rules...)
+		b.origins.insertDNS(0, RuleOrigin{Kind: RuleOriginBuiltIn, Description: "dns and outbound servers resolved directly"})
 	}
 }
 
-func setOutbounds(options *option.Options, input *option.Options, opt *HiddifyOptions) error {
-	directDNSDomains := make(map[string]bool)
-	var outbounds []option.Outbound
-	var tags []string
-	OutboundMainProxyTag = OutboundSelectTag
-	// inbound==warp over proxies
-	// outbound==proxies over warp
+// setWarp turns the warp settings off when the config already has their
+// account and picks the main proxy outbound.
+// inbound==warp over proxies
+// outbound==proxies over warp
+func setWarp(opt *HiddifyOptions, input *option.Options, b *build) {
 	if opt.Warp.EnableWarp {
 		for _, out := range input.Outbounds {
 			if out.Type == C.TypeCustom {
 				if warp, ok := out.CustomOptions["warp"].(map[string]interface{}); ok {
 					key, _ := warp["key"].(string)
-					if key == "p1" {
+					if key == "p1" || opt.Warp.VaultId != "" && key == WarpVaultKey(opt.Warp.VaultId) {
 						opt.Warp.EnableWarp = false
 						break
 					}
@@ -150,24 +167,41 @@ func setOutbounds(options *option.Options, input *option.Options, opt *HiddifyOp
 			}
 		}
 	}
+	if opt.Warp.EnableWarp && opt.Warp.Mode == "warp_over_proxy" {
+		b.mainProxyTag = OutboundWarpTag
+	}
+}
+
+func setOutbounds(options *option.Options, input *option.Options, opt *HiddifyOptions, b *build) error {
+	directDNSDomains := make(map[string]bool)
+	var outbounds []option.Outbound
+	var proxies []option.Outbound
+	var tags []string
 	if opt.Warp.EnableWarp && (opt.Warp.Mode == "warp_over_proxy" || opt.Warp.Mode == "proxy_over_warp") {
-		out, err := GenerateWarpSingbox(opt.Warp.WireguardConfig, opt.Warp.CleanIP, opt.Warp.CleanPort, opt.Warp.FakePackets, opt.Warp.FakePacketSize, opt.Warp.FakePacketDelay, opt.Warp.FakePacketMode)
+		wireguardConfig := opt.Warp.WireguardConfig
+		if opt.Warp.VaultId != "" {
+			var err error
+			wireguardConfig, err = b.Warp.Vault(opt.Warp.VaultId)
+			if err != nil {
+				return err
+			}
+		}
+		out, err := GenerateWarpSingbox(wireguardConfig, opt.Warp.CleanIP, opt.Warp.CleanPort, opt.Warp.FakePackets, opt.Warp.FakePacketSize, opt.Warp.FakePacketDelay, opt.Warp.FakePacketMode)
 		if err != nil {
 			return fmt.Errorf("failed to generate warp config: %v", err)
 		}
-		out.Tag = "Hiddify Warp ✅"
+		out.Tag = OutboundWarpTag
 		if opt.Warp.Mode == "warp_over_proxy" {
 			out.WireGuardOptions.Detour = OutboundSelectTag
-			OutboundMainProxyTag = out.Tag
 		} else {
 			out.WireGuardOptions.Detour = OutboundDirectTag
 		}
-		patchWarp(out, opt, true, nil)
+		patchWarp(out, opt, true, options.DNS.StaticIPs, b)
 		outbounds = append(outbounds, *out)
 		// tags = append(tags, out.Tag)
 	}
 	for _, out := range input.Outbounds {
-		outbound, serverDomain, err := patchOutbound(out, *opt, options.DNS.StaticIPs)
+		outbound, serverDomain, err := patchOutbound(out, *opt, options.DNS.StaticIPs, b)
 		if err != nil {
 			return err
 		}
@@ -190,9 +224,29 @@ func setOutbounds(options *option.Options, input *option.Options, opt *HiddifyOp
 			}
 			out = patchHiddifyWarpFromConfig(out, *opt)
 			outbounds = append(outbounds, out)
+			proxies = append(proxies, out)
 		}
 	}
 
+	groups, err := makeGroupOutbounds(opt.Groups, proxies, opt)
+	if err != nil {
+		return err
+	}
+	groupTags := make([]string, 0, len(groups)+len(opt.Chains))
+	groupMembers := map[string][]string{OutboundURLTestTag: tags}
+	for _, group := range groups {
+		groupTags = append(groupTags, group.Tag)
+		groupMembers[group.Tag] = append(group.SelectorOptions.Outbounds, group.URLTestOptions.Outbounds...)
+	}
+	chains, err := makeChainOutbounds(opt.Chains, proxies, groupMembers, opt)
+	if err != nil {
+		return err
+	}
+	for _, chain := range opt.Chains {
+		groupTags = append(groupTags, chain.Tag)
+	}
+	groups = append(groups, chains...)
+
 	urlTest := option.Outbound{
 		Type: C.TypeURLTest,
 		Tag:  OutboundURLTestTag,
@@ -206,6 +260,19 @@ func setOutbounds(options *option.Options, input *option.Options, opt *HiddifyOp
 			InterruptExistConnections: true,
 		},
 	}
+	if opt.AutoGroupType == GroupTypeFallback {
+		urlTest = option.Outbound{
+			Type: C.TypeSelector,
+			Tag:  OutboundURLTestTag,
+			SelectorOptions: option.SelectorOutboundOptions{
+				Outbounds:                 tags,
+				InterruptExistConnections: true,
+			},
+		}
+		if len(tags) > 0 {
+			urlTest.SelectorOptions.Default = tags[0]
+		}
+	}
 	defaultSelect := urlTest.Tag
 
 	for _, tag := range tags {
@@ -213,17 +280,21 @@ func setOutbounds(options *option.Options, input *option.Options, opt *HiddifyOp
 			defaultSelect = "§default§"
 		}
 	}
+	selectTags := append(append([]string{urlTest.Tag}, groupTags...), tags...)
+	if opt.SelectorDefault != "" && slices.Contains(selectTags, opt.SelectorDefault) {
+		defaultSelect = opt.SelectorDefault
+	}
 	selector := option.Outbound{
 		Type: C.TypeSelector,
 		Tag:  OutboundSelectTag,
 		SelectorOptions: option.SelectorOutboundOptions{
-			Outbounds:                 append([]string{urlTest.Tag}, tags...),
+			Outbounds:                 selectTags,
 			Default:                   defaultSelect,
 			InterruptExistConnections: true,
 		},
 	}
 
-	outbounds = append([]option.Outbound{selector, urlTest}, outbounds...)
+	outbounds = append(append([]option.Outbound{selector, urlTest}, groups...), outbounds...)
 
 	options.Outbounds = append(
 		outbounds,
@@ -261,14 +332,14 @@ func setOutbounds(options *option.Options, input *option.Options, opt *HiddifyOp
 		}...,
 	)
 
-	addForceDirect(options, opt, directDNSDomains)
+	addForceDirect(options, opt, b, directDNSDomains)
 	return nil
 }
 
-func setClashAPI(options *option.Options, opt *HiddifyOptions) {
+func setClashAPI(options *option.Options, opt *HiddifyOptions, b *build) {
 	if opt.EnableClashApi {
 		if opt.ClashApiSecret == "" {
-			opt.ClashApiSecret = generateRandomString(16)
+			opt.ClashApiSecret = b.Random.String(16)
 		}
 		options.Experimental = &option.ExperimentalOptions{
 			ClashAPI: &option.ClashAPIOptions{
@@ -342,6 +413,7 @@ func setInbound(options *option.Options, opt *HiddifyOptions) {
 		options.Inbounds = append(options.Inbounds, tunInbound)
 
 	}
+	options.Inbounds = append(options.Inbounds, makeTransparentProxyInbounds(opt, inboundDomainStrategy)...)
 
 	var bind string
 	if opt.AllowConnectionFromLAN {
@@ -366,6 +438,7 @@ func setInbound(options *option.Options, opt *HiddifyOptions) {
 					},
 				},
 				SetSystemProxy: opt.SetSystemProxy,
+				Users:          lanShareUsers(opt),
 			},
 		},
 	)
@@ -387,7 +460,7 @@ func setInbound(options *option.Options, opt *HiddifyOptions) {
 	)
 }
 
-func setDns(options *option.Options, opt *HiddifyOptions) {
+func setDns(options *option.Options, opt *HiddifyOptions, b *build) {
 	options.DNS = &option.DNSOptions{
 		StaticIPs: map[string][]string{},
 		DNSClientOptions: option.DNSClientOptions{
@@ -426,13 +499,13 @@ func setDns(options *option.Options, opt *HiddifyOptions) {
 			},
 		},
 	}
-	sky_rethinkdns := getIPs([]string{"www.speedtest.net", "sky.rethinkdns.com"})
+	sky_rethinkdns := getIPs(b.Prober, []string{"www.speedtest.net", "sky.rethinkdns.com"})
 	if len(sky_rethinkdns) > 0 {
 		options.DNS.StaticIPs["sky.rethinkdns.com"] = sky_rethinkdns
 	}
 }
 
-func setFakeDns(options *option.Options, opt *HiddifyOptions) {
+func setFakeDns(options *option.Options, opt *HiddifyOptions, b *build) {
 	if opt.EnableFakeDNS {
 		inet4Range := netip.MustParsePrefix("198.18.0.0/15")
 		inet6Range := netip.MustParsePrefix("fc00::/18")
@@ -449,6 +522,7 @@ func setFakeDns(options *option.Options, opt *HiddifyOptions) {
 				Strategy: option.DomainStrategy(dns.DomainStrategyUseIPv4),
 			},
 		)
+		b.origins.insertDNS(len(options.DNS.Rules), RuleOrigin{Kind: RuleOriginBuiltIn, Description: "fake dns"})
 		options.DNS.Rules = append(
 			options.DNS.Rules,
 			option.DNSRule{
@@ -464,14 +538,29 @@ func setFakeDns(options *option.Options, opt *HiddifyOptions) {
 	}
 }
 
-func setRoutingOptions(options *option.Options, opt *HiddifyOptions) {
-	dnsRules := []option.DefaultDNSRule{}
+func setRoutingOptions(options *option.Options, opt *HiddifyOptions, b *build) {
+	dnsRules := []option.DNSRule{}
+	dnsOrigins := []RuleOrigin{}
 	routeRules := []option.Rule{}
 	rulesets := []option.RuleSet{}
+	addRoute := func(origin RuleOrigin, rules ...option.Rule) {
+		routeRules = append(routeRules, rules...)
+		b.origins.addRoute(origin, len(rules))
+	}
+	addDNS := func(origin RuleOrigin, rules ...option.DNSRule) {
+		for _, rule := range rules {
+			dnsRules = append(dnsRules, rule)
+			dnsOrigins = append(dnsOrigins, origin)
+		}
+	}
+
+	if rule, ok := makeLANShareRule(opt); ok {
+		addRoute(RuleOrigin{Kind: RuleOriginLANShare, Description: "clients not allowed to use the lan share"}, rule)
+	}
 
 	if opt.EnableTun && runtime.GOOS == "android" {
-		routeRules = append(
-			routeRules,
+		addRoute(
+			RuleOrigin{Kind: RuleOriginBuiltIn, Description: "hiddify app bypasses the tun"},
 			option.Rule{
 				Type: C.RuleTypeDefault,
 
@@ -493,14 +582,14 @@ func setRoutingOptions(options *option.Options, opt *HiddifyOptions) {
 		// 	},
 		// )
 	}
-	routeRules = append(routeRules, option.Rule{
+	addRoute(RuleOrigin{Kind: RuleOriginBuiltIn, Description: "dns inbound"}, option.Rule{
 		Type: C.RuleTypeDefault,
 		DefaultOptions: option.DefaultRule{
 			Inbound:  []string{InboundDNSTag},
 			Outbound: OutboundDNSTag,
 		},
 	})
-	routeRules = append(routeRules, option.Rule{
+	addRoute(RuleOrigin{Kind: RuleOriginBuiltIn, Description: "dns port"}, option.Rule{
 		Type: C.RuleTypeDefault,
 		DefaultOptions: option.DefaultRule{
 			Port:     []uint16{53},
@@ -524,8 +613,8 @@ func setRoutingOptions(options *option.Options, opt *HiddifyOptions) {
 	// },	}
 
 	if opt.BypassLAN {
-		routeRules = append(
-			routeRules,
+		addRoute(
+			RuleOrigin{Kind: RuleOriginBypassLAN, Description: "private addresses bypass the proxy"},
 			option.Rule{
 				Type: C.RuleTypeDefault,
 				DefaultOptions: option.DefaultRule{
@@ -537,181 +626,105 @@ func setRoutingOptions(options *option.Options, opt *HiddifyOptions) {
 		)
 	}
 
-	for _, rule := range opt.Rules {
-		routeRule := rule.MakeRule()
+	for i, rule := range opt.Rules {
+		origin := RuleOrigin{Kind: RuleOriginRule, Index: i, Rule: &opt.Rules[i]}
+		outbound := rule.Outbound
 		switch rule.Outbound {
 		case "bypass":
-			routeRule.Outbound = OutboundBypassTag
+			outbound = OutboundBypassTag
 		case "block":
-			routeRule.Outbound = OutboundBlockTag
+			outbound = OutboundBlockTag
 		case "proxy":
-			routeRule.Outbound = OutboundMainProxyTag
+			outbound = b.mainProxyTag
 		}
-
-		if routeRule.IsValid() {
-			routeRules = append(
-				routeRules,
-				option.Rule{
-					Type:           C.RuleTypeDefault,
-					DefaultOptions: routeRule,
-				},
-			)
+		if routeRule, ok := rule.MakeRouteRule(outbound); ok {
+			addRoute(origin, routeRule)
 		}
 
-		dnsRule := rule.MakeDNSRule()
-		switch rule.Outbound {
-		case "bypass":
-			dnsRule.Server = DNSDirectTag
-		case "block":
-			dnsRule.Server = DNSBlockTag
-			dnsRule.DisableCache = true
-		case "proxy":
-			if opt.EnableFakeDNS {
-				fakeDnsRule := dnsRule
-				fakeDnsRule.Server = DNSFakeTag
-				fakeDnsRule.Inbound = []string{InboundTUNTag, InboundMixedTag}
-				dnsRules = append(dnsRules, fakeDnsRule)
+		server := DNSRemoteTag
+		switch outbound {
+		case OutboundBypassTag, OutboundDirectTag:
+			server = DNSDirectTag
+		case OutboundBlockTag:
+			server = DNSBlockTag
+		}
+		dnsRule, ok := rule.MakeDNSRouteRule(server)
+		if !ok {
+			continue
+		}
+		if server == DNSBlockTag {
+			dnsRule.DefaultOptions.DisableCache = true
+			dnsRule.LogicalOptions.DisableCache = true
+		}
+		if server == DNSRemoteTag && opt.EnableFakeDNS {
+			if fakeDnsRule, ok := rule.MakeFakeDNSRule(DNSFakeTag, []string{InboundTUNTag, InboundMixedTag}); ok {
+				addDNS(origin, fakeDnsRule)
 			}
-			dnsRule.Server = DNSRemoteTag
 		}
-		dnsRules = append(dnsRules, dnsRule)
+		addDNS(origin, dnsRule)
 	}
 
 	parsedURL, err := url.Parse(opt.ConnectionTestUrl)
 	if err == nil {
 		var dnsCPttl uint32 = 3000
-		dnsRules = append(dnsRules, option.DefaultDNSRule{
-			Domain:       []string{parsedURL.Host},
-			Server:       DNSRemoteTag,
-			RewriteTTL:   &dnsCPttl,
-			DisableCache: false,
+		addDNS(RuleOrigin{Kind: RuleOriginConnectionTest, Description: "connection test host"}, option.DNSRule{
+			Type: C.RuleTypeDefault,
+			DefaultOptions: option.DefaultDNSRule{
+				Domain:       []string{parsedURL.Host},
+				Server:       DNSRemoteTag,
+				RewriteTTL:   &dnsCPttl,
+				DisableCache: false,
+			},
 		})
 	}
 
 	if opt.BlockAds {
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geosite-ads",
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/block/geosite-category-ads-all.srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
-			},
-		})
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geosite-malware",
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/block/geosite-malware.srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
-			},
-		})
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geosite-phishing",
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/block/geosite-phishing.srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
-			},
-		})
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geosite-cryptominers",
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/block/geosite-cryptominers.srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
-			},
-		})
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geoip-phishing",
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/block/geoip-phishing.srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
-			},
-		})
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geoip-malware",
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/block/geoip-malware.srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
-			},
-		})
-
-		routeRules = append(routeRules, option.Rule{
+		blockAds := RuleOrigin{Kind: RuleOriginBlockAds, Description: "ads, malware, phishing and cryptominers"}
+		addRoute(blockAds, option.Rule{
 			Type: C.RuleTypeDefault,
 			DefaultOptions: option.DefaultRule{
-				RuleSet: []string{
-					"geosite-ads",
-					"geosite-malware",
-					"geosite-phishing",
-					"geosite-cryptominers",
-					"geoip-malware",
-					"geoip-phishing",
-				},
+				RuleSet:  blockRuleSetTags,
 				Outbound: OutboundBlockTag,
 			},
 		})
-		dnsRules = append(dnsRules, option.DefaultDNSRule{
-			RuleSet: []string{
-				"geosite-ads",
-				"geosite-malware",
-				"geosite-phishing",
-				"geosite-cryptominers",
-				"geoip-malware",
-				"geoip-phishing",
+		addDNS(blockAds, option.DNSRule{
+			Type: C.RuleTypeDefault,
+			DefaultOptions: option.DefaultDNSRule{
+				RuleSet: blockRuleSetTags,
+				Server:  DNSBlockTag,
+				//		DisableCache: true,
 			},
-			Server: DNSBlockTag,
-			//		DisableCache: true,
 		})
 
 	}
 	if opt.Region != "other" {
-		dnsRules = append(dnsRules, option.DefaultDNSRule{
-			DomainSuffix: []string{"." + opt.Region},
-			Server:       DNSDirectTag,
+		region := RuleOrigin{Kind: RuleOriginRegion, Description: "region " + opt.Region + " goes direct"}
+		addDNS(region, option.DNSRule{
+			Type: C.RuleTypeDefault,
+			DefaultOptions: option.DefaultDNSRule{
+				DomainSuffix: []string{"." + opt.Region},
+				Server:       DNSDirectTag,
+			},
 		})
-		routeRules = append(routeRules, option.Rule{
+		addRoute(region, option.Rule{
 			Type: C.RuleTypeDefault,
 			DefaultOptions: option.DefaultRule{
 				DomainSuffix: []string{"." + opt.Region},
 				Outbound:     OutboundDirectTag,
 			},
 		})
-		dnsRules = append(dnsRules, option.DefaultDNSRule{
-			RuleSet: []string{
-				"geoip-" + opt.Region,
-				"geosite-" + opt.Region,
-			},
-			Server: DNSDirectTag,
-		})
-
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geoip-" + opt.Region,
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/country/geoip-" + opt.Region + ".srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
-			},
-		})
-		rulesets = append(rulesets, option.RuleSet{
-			Type:   C.RuleSetTypeRemote,
-			Tag:    "geosite-" + opt.Region,
-			Format: C.RuleSetFormatBinary,
-			RemoteOptions: option.RemoteRuleSet{
-				URL:            "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/country/geosite-" + opt.Region + ".srs",
-				UpdateInterval: option.Duration(5 * time.Hour * 24),
+		addDNS(region, option.DNSRule{
+			Type: C.RuleTypeDefault,
+			DefaultOptions: option.DefaultDNSRule{
+				RuleSet: []string{
+					"geoip-" + opt.Region,
+					"geosite-" + opt.Region,
+				},
+				Server: DNSDirectTag,
 			},
 		})
 
-		routeRules = append(routeRules, option.Rule{
+		addRoute(region, option.Rule{
 			Type: C.RuleTypeDefault,
 			DefaultOptions: option.DefaultRule{
 				RuleSet: []string{
@@ -723,9 +736,12 @@ func setRoutingOptions(options *option.Options, opt *HiddifyOptions) {
 		})
 
 	}
+	for _, source := range RuleSetSources(opt) {
+		rulesets = append(rulesets, makeRuleSet(opt, b.Files, source))
+	}
 	options.Route = &option.RouteOptions{
 		Rules:               routeRules,
-		Final:               OutboundMainProxyTag,
+		Final:               b.mainProxyTag,
 		AutoDetectInterface: true,
 		OverrideAndroidVPN:  true,
 		RuleSet:             rulesets,
@@ -737,15 +753,10 @@ func setRoutingOptions(options *option.Options, opt *HiddifyOptions) {
 		// },
 	}
 	if opt.EnableDNSRouting {
-		for _, dnsRule := range dnsRules {
+		for i, dnsRule := range dnsRules {
 			if dnsRule.IsValid() {
-				options.DNS.Rules = append(
-					options.DNS.Rules,
-					option.DNSRule{
-						Type:           C.RuleTypeDefault,
-						DefaultOptions: dnsRule,
-					},
-				)
+				b.origins.insertDNS(len(options.DNS.Rules), dnsOrigins[i])
+				options.DNS.Rules = append(options.DNS.Rules, dnsRule)
 			}
 		}
 	}
@@ -753,80 +764,17 @@ func setRoutingOptions(options *option.Options, opt *HiddifyOptions) {
 
 func patchHiddifyWarpFromConfig(out option.Outbound, opt HiddifyOptions) option.Outbound {
 	if opt.Warp.EnableWarp && opt.Warp.Mode == "proxy_over_warp" {
-		// Handle UAP protocol (sibling of VLESS) through JSON map
-		if out.Type == "uap" {
-			jsonData, err := out.MarshalJSON()
-			if err == nil {
-				var obj map[string]interface{}
-				if err := json.Unmarshal(jsonData, &obj); err == nil {
-					// UAP uses the same structure as VLESS, check detour field
-					if detour, ok := obj["detour"].(string); !ok || detour == "" {
-						obj["detour"] = "Hiddify Warp ✅"
-						modifiedJson, err := json.Marshal(obj)
-						if err == nil {
-							var uapOutbound option.Outbound
-							if err := uapOutbound.UnmarshalJSON(modifiedJson); err == nil {
-								return uapOutbound
-							}
-						}
-					}
-				}
-			}
-		}
-		
-		if out.DirectOptions.Detour == "" {
-			out.DirectOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.HTTPOptions.Detour == "" {
-			out.HTTPOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.Hysteria2Options.Detour == "" {
-			out.Hysteria2Options.Detour = "Hiddify Warp ✅"
-		}
-		if out.HysteriaOptions.Detour == "" {
-			out.HysteriaOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.SSHOptions.Detour == "" {
-			out.SSHOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.ShadowTLSOptions.Detour == "" {
-			out.ShadowTLSOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.ShadowsocksOptions.Detour == "" {
-			out.ShadowsocksOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.ShadowsocksROptions.Detour == "" {
-			out.ShadowsocksROptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.SocksOptions.Detour == "" {
-			out.SocksOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.TUICOptions.Detour == "" {
-			out.TUICOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.TorOptions.Detour == "" {
-			out.TorOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.TrojanOptions.Detour == "" {
-			out.TrojanOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.VLESSOptions.Detour == "" {
-			out.VLESSOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.VMessOptions.Detour == "" {
-			out.VMessOptions.Detour = "Hiddify Warp ✅"
-		}
-		if out.WireGuardOptions.Detour == "" {
-			out.WireGuardOptions.Detour = "Hiddify Warp ✅"
+		if patched, err := withDetour(out, "Hiddify Warp ✅", false); err == nil {
+			return patched
 		}
 	}
 	return out
 }
 
-func getIPs(domains []string) []string {
+func getIPs(prober Prober, domains []string) []string {
 	res := []string{}
 	for _, d := range domains {
-		ips, err := net.LookupHost(d)
+		ips, err := prober.LookupHost(d)
 		if err != nil {
 			continue
 		}
@@ -839,18 +787,16 @@ func getIPs(domains []string) []string {
 	return res
 }
 
-func isBlockedDomain(domain string) bool {
+func isBlockedDomain(prober Prober, domain string) bool {
 	if strings.HasPrefix("full:", domain) {
 		return false
 	}
-	ips, err := net.LookupHost(domain)
+	ips, err := prober.LookupHost(domain)
 	if err != nil {
 		// fmt.Println(err)
 		return true
 	}
 
-	// Print the IP addresses associated with the domain
-	fmt.Printf("IP addresses for %s:\n", domain)
 	for _, ip := range ips {
 		if strings.HasPrefix(ip, "10.") {
 			return true
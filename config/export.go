@@ -0,0 +1,532 @@
+package config
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"gopkg.in/yaml.v3"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatShareLink ExportFormat = "uri"
+	ExportFormatClash     ExportFormat = "clash"
+	ExportFormatSingbox   ExportFormat = "singbox"
+)
+
+type ExportResult struct {
+	Content string
+	// Skipped lists the tags that can not be represented in the format.
+	Skipped []string
+}
+
+// ExportConfigContent parses content in any supported format and exports its
+// outbounds. Default options are used so no TLS tricks or mux are added, and
+// warp outbounds are kept as they are instead of registering a device.
+func ExportConfigContent(content string, format ExportFormat, tags []string) (*ExportResult, error) {
+	parsed, err := parseConfigContent(content, false, DefaultHiddifyOptions(), false, false)
+	if err != nil {
+		return nil, err
+	}
+	return ExportOutbounds(parsed, format, tags)
+}
+
+func ExportOptions(options *option.Options, format ExportFormat, tags []string) (*ExportResult, error) {
+	content, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	return ExportOutbounds(content, format, tags)
+}
+
+// ExportOutbounds converts the outbounds of a sing-box config back to share
+// links, a Clash Meta proxies list or plain sing-box outbounds. It works on
+// the JSON form so map based outbounds such as uap are exported as well.
+// Groups and built-in outbounds are never exported; when tags is not empty
+// only the matching outbounds are.
+func ExportOutbounds(content []byte, format ExportFormat, tags []string) (*ExportResult, error) {
+	var config struct {
+		Outbounds []outboundMap `json:"outbounds"`
+	}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("invalid sing-box config: %w", err)
+	}
+	var outbounds []outboundMap
+	for _, obj := range config.Outbounds {
+		if isExportable(obj) && matchesExportTags(obj, tags) {
+			outbounds = append(outbounds, obj)
+		}
+	}
+
+	switch format {
+	case ExportFormatShareLink, "":
+		return exportShareLinks(outbounds), nil
+	case ExportFormatClash:
+		return exportClash(outbounds)
+	case ExportFormatSingbox:
+		return exportSingbox(outbounds)
+	default:
+		return nil, fmt.Errorf("unknown export format %s", format)
+	}
+}
+
+func isExportable(obj outboundMap) bool {
+	switch getStringFromMap(obj, "type") {
+	case C.TypeSelector, C.TypeURLTest, C.TypeDirect, C.TypeBlock, C.TypeDNS, "":
+		return false
+	}
+	return true
+}
+
+func matchesExportTags(obj outboundMap, tags []string) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	tag := getStringFromMap(obj, "tag")
+	for _, t := range tags {
+		if t == tag || t == exportName(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportName strips the " § n" suffix ray2sing adds to make tags unique.
+func exportName(obj outboundMap) string {
+	tag := getStringFromMap(obj, "tag")
+	if i := strings.LastIndex(tag, " § "); i > 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+func exportShareLinks(outbounds []outboundMap) *ExportResult {
+	result := &ExportResult{}
+	var links []string
+	for _, obj := range outbounds {
+		link, err := outboundToShareLink(obj)
+		if err != nil {
+			result.Skipped = append(result.Skipped, getStringFromMap(obj, "tag"))
+			continue
+		}
+		links = append(links, link)
+	}
+	result.Content = strings.Join(links, "\n")
+	return result
+}
+
+func outboundToShareLink(obj outboundMap) (string, error) {
+	link := &url.URL{
+		Host:     net.JoinHostPort(getStringFromMap(obj, "server"), strconv.Itoa(getIntFromMap(obj, "server_port"))),
+		Fragment: exportName(obj),
+	}
+	query := url.Values{}
+	switch outboundType := getStringFromMap(obj, "type"); outboundType {
+	case C.TypeVLESS:
+		link.Scheme = outboundType
+		link.User = url.User(getStringFromMap(obj, "uuid"))
+		setQuery(query, "flow", getStringFromMap(obj, "flow"))
+		setQuery(query, "packetEncoding", getStringFromMap(obj, "packet_encoding"))
+		tlsShareParams(obj, query)
+		transportShareParams(obj, query)
+	case C.TypeVMess:
+		return vmessShareLink(obj), nil
+	case C.TypeTrojan:
+		link.Scheme = "trojan"
+		link.User = url.User(getStringFromMap(obj, "password"))
+		tlsShareParams(obj, query)
+		transportShareParams(obj, query)
+	case C.TypeShadowsocks:
+		link.Scheme = "ss"
+		userInfo := getStringFromMap(obj, "method") + ":" + getStringFromMap(obj, "password")
+		link.User = url.User(base64.RawURLEncoding.EncodeToString([]byte(userInfo)))
+		if plugin := getStringFromMap(obj, "plugin"); plugin != "" {
+			pluginOpts := getStringFromMap(obj, "plugin_opts")
+			if pluginOpts != "" {
+				plugin += ";" + pluginOpts
+			}
+			query.Set("plugin", plugin)
+		}
+	case C.TypeHysteria2:
+		link.Scheme = "hy2"
+		link.User = url.User(getStringFromMap(obj, "password"))
+		tls := getMapFromMap(obj, "tls")
+		setQuery(query, "sni", getStringFromMap(tls, "server_name"))
+		if getBoolFromMap(tls, "insecure") {
+			query.Set("insecure", "1")
+		}
+		obfs := getMapFromMap(obj, "obfs")
+		setQuery(query, "obfs", getStringFromMap(obfs, "type"))
+		setQuery(query, "obfs-password", getStringFromMap(obfs, "password"))
+	case C.TypeTUIC:
+		link.Scheme = "tuic"
+		link.User = url.UserPassword(getStringFromMap(obj, "uuid"), getStringFromMap(obj, "password"))
+		setQuery(query, "congestion_control", getStringFromMap(obj, "congestion_control"))
+		setQuery(query, "udp_relay_mode", getStringFromMap(obj, "udp_relay_mode"))
+		tls := getMapFromMap(obj, "tls")
+		setQuery(query, "sni", getStringFromMap(tls, "server_name"))
+		setQuery(query, "alpn", strings.Join(getStringsFromMap(tls, "alpn"), ","))
+		if getBoolFromMap(tls, "insecure") {
+			query.Set("allow_insecure", "1")
+		}
+	case C.TypeWireGuard:
+		link.Scheme = "wg"
+		setQuery(query, "pk", getStringFromMap(obj, "private_key"))
+		setQuery(query, "peer_public_key", getStringFromMap(obj, "peer_public_key"))
+		setQuery(query, "psk", getStringFromMap(obj, "pre_shared_key"))
+		setQuery(query, "local_address", strings.Join(getStringsFromMap(obj, "local_address"), ","))
+		if mtu := getIntFromMap(obj, "mtu"); mtu > 0 {
+			query.Set("mtu", strconv.Itoa(mtu))
+		}
+		if reserved := getIntsFromMap(obj, "reserved"); len(reserved) > 0 {
+			parts := make([]string, len(reserved))
+			for i, r := range reserved {
+				parts[i] = strconv.Itoa(r)
+			}
+			query.Set("reserved", strings.Join(parts, ","))
+		}
+	default:
+		return "", fmt.Errorf("%s can not be exported as a link", outboundType)
+	}
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
+
+func vmessShareLink(obj outboundMap) string {
+	values := map[string]string{
+		"v":    "2",
+		"ps":   exportName(obj),
+		"add":  getStringFromMap(obj, "server"),
+		"port": strconv.Itoa(getIntFromMap(obj, "server_port")),
+		"id":   getStringFromMap(obj, "uuid"),
+		"aid":  strconv.Itoa(getIntFromMap(obj, "alter_id")),
+		"scy":  getStringFromMap(obj, "security"),
+		"type": "none",
+	}
+	query := url.Values{}
+	tlsShareParams(obj, query)
+	transportShareParams(obj, query)
+	values["net"] = query.Get("type")
+	values["host"] = query.Get("host")
+	values["path"] = query.Get("path")
+	if serviceName := query.Get("serviceName"); serviceName != "" {
+		values["path"] = serviceName
+	}
+	if query.Get("security") != "" {
+		values["tls"] = "tls"
+	}
+	values["sni"] = query.Get("sni")
+	values["alpn"] = query.Get("alpn")
+	values["fp"] = query.Get("fp")
+	content, _ := json.Marshal(values)
+	return "vmess://" + base64.StdEncoding.EncodeToString(content)
+}
+
+func tlsShareParams(obj outboundMap, query url.Values) {
+	tls := getMapFromMap(obj, "tls")
+	if !getBoolFromMap(tls, "enabled") {
+		return
+	}
+	query.Set("security", "tls")
+	setQuery(query, "sni", getStringFromMap(tls, "server_name"))
+	setQuery(query, "alpn", strings.Join(getStringsFromMap(tls, "alpn"), ","))
+	if getBoolFromMap(tls, "insecure") {
+		query.Set("allowInsecure", "1")
+	}
+	setQuery(query, "fp", getStringFromMap(getMapFromMap(tls, "utls"), "fingerprint"))
+	if reality := getMapFromMap(tls, "reality"); getBoolFromMap(reality, "enabled") {
+		query.Set("security", "reality")
+		setQuery(query, "pbk", getStringFromMap(reality, "public_key"))
+		setQuery(query, "sid", getStringFromMap(reality, "short_id"))
+	}
+}
+
+func transportShareParams(obj outboundMap, query url.Values) {
+	transport := getMapFromMap(obj, "transport")
+	transportType := getStringFromMap(transport, "type")
+	switch transportType {
+	case "":
+		query.Set("type", "tcp")
+		return
+	case C.V2RayTransportTypeGRPC:
+		setQuery(query, "serviceName", getStringFromMap(transport, "service_name"))
+	case C.V2RayTransportTypeHTTP:
+		setQuery(query, "host", strings.Join(getStringsFromMap(transport, "host"), ","))
+		setQuery(query, "path", getStringFromMap(transport, "path"))
+	default:
+		setQuery(query, "path", getStringFromMap(transport, "path"))
+		host := getStringFromMap(transport, "host")
+		if host == "" {
+			host = getStringFromMap(getMapFromMap(transport, "headers"), "Host")
+		}
+		setQuery(query, "host", host)
+	}
+	query.Set("type", transportType)
+}
+
+func exportClash(outbounds []outboundMap) (*ExportResult, error) {
+	result := &ExportResult{}
+	proxies := []map[string]any{}
+	for _, obj := range outbounds {
+		proxy, err := outboundToClash(obj)
+		if err != nil {
+			result.Skipped = append(result.Skipped, getStringFromMap(obj, "tag"))
+			continue
+		}
+		proxies = append(proxies, proxy)
+	}
+	content, err := yaml.Marshal(map[string]any{"proxies": proxies})
+	if err != nil {
+		return nil, err
+	}
+	result.Content = string(content)
+	return result, nil
+}
+
+func outboundToClash(obj outboundMap) (map[string]any, error) {
+	proxy := map[string]any{
+		"name":   exportName(obj),
+		"server": getStringFromMap(obj, "server"),
+		"port":   getIntFromMap(obj, "server_port"),
+	}
+	tls := getMapFromMap(obj, "tls")
+	switch outboundType := getStringFromMap(obj, "type"); outboundType {
+	case C.TypeVLESS:
+		proxy["type"] = "vless"
+		proxy["uuid"] = getStringFromMap(obj, "uuid")
+		setClash(proxy, "flow", getStringFromMap(obj, "flow"))
+		tlsClashParams(tls, proxy, "servername")
+		transportClashParams(obj, proxy)
+	case C.TypeVMess:
+		proxy["type"] = "vmess"
+		proxy["uuid"] = getStringFromMap(obj, "uuid")
+		proxy["alterId"] = getIntFromMap(obj, "alter_id")
+		proxy["cipher"] = getStringFromMap(obj, "security")
+		if proxy["cipher"] == "" {
+			proxy["cipher"] = "auto"
+		}
+		tlsClashParams(tls, proxy, "servername")
+		transportClashParams(obj, proxy)
+	case C.TypeTrojan:
+		proxy["type"] = "trojan"
+		proxy["password"] = getStringFromMap(obj, "password")
+		tlsClashParams(tls, proxy, "sni")
+		transportClashParams(obj, proxy)
+	case C.TypeShadowsocks:
+		if getStringFromMap(obj, "plugin") != "" {
+			return nil, fmt.Errorf("shadowsocks plugins are not exported")
+		}
+		proxy["type"] = "ss"
+		proxy["cipher"] = getStringFromMap(obj, "method")
+		proxy["password"] = getStringFromMap(obj, "password")
+	case C.TypeHysteria2:
+		proxy["type"] = "hysteria2"
+		proxy["password"] = getStringFromMap(obj, "password")
+		setClash(proxy, "sni", getStringFromMap(tls, "server_name"))
+		if getBoolFromMap(tls, "insecure") {
+			proxy["skip-cert-verify"] = true
+		}
+		obfs := getMapFromMap(obj, "obfs")
+		setClash(proxy, "obfs", getStringFromMap(obfs, "type"))
+		setClash(proxy, "obfs-password", getStringFromMap(obfs, "password"))
+	case C.TypeTUIC:
+		proxy["type"] = "tuic"
+		proxy["uuid"] = getStringFromMap(obj, "uuid")
+		proxy["password"] = getStringFromMap(obj, "password")
+		setClash(proxy, "congestion-controller", getStringFromMap(obj, "congestion_control"))
+		setClash(proxy, "udp-relay-mode", getStringFromMap(obj, "udp_relay_mode"))
+		setClash(proxy, "sni", getStringFromMap(tls, "server_name"))
+		if alpn := getStringsFromMap(tls, "alpn"); len(alpn) > 0 {
+			proxy["alpn"] = alpn
+		}
+		if getBoolFromMap(tls, "insecure") {
+			proxy["skip-cert-verify"] = true
+		}
+	case C.TypeWireGuard:
+		proxy["type"] = "wireguard"
+		proxy["private-key"] = getStringFromMap(obj, "private_key")
+		proxy["public-key"] = getStringFromMap(obj, "peer_public_key")
+		setClash(proxy, "pre-shared-key", getStringFromMap(obj, "pre_shared_key"))
+		for _, prefix := range getStringsFromMap(obj, "local_address") {
+			ip, _, _ := strings.Cut(prefix, "/")
+			if strings.Contains(ip, ":") {
+				proxy["ipv6"] = ip
+			} else {
+				proxy["ip"] = ip
+			}
+		}
+		if mtu := getIntFromMap(obj, "mtu"); mtu > 0 {
+			proxy["mtu"] = mtu
+		}
+		if reserved := getIntsFromMap(obj, "reserved"); len(reserved) > 0 {
+			proxy["reserved"] = reserved
+		}
+		proxy["udp"] = true
+	default:
+		return nil, fmt.Errorf("%s is not supported by clash", outboundType)
+	}
+	return proxy, nil
+}
+
+func tlsClashParams(tls map[string]any, proxy map[string]any, sniKey string) {
+	if !getBoolFromMap(tls, "enabled") {
+		return
+	}
+	proxy["tls"] = true
+	setClash(proxy, sniKey, getStringFromMap(tls, "server_name"))
+	if getBoolFromMap(tls, "insecure") {
+		proxy["skip-cert-verify"] = true
+	}
+	if alpn := getStringsFromMap(tls, "alpn"); len(alpn) > 0 {
+		proxy["alpn"] = alpn
+	}
+	setClash(proxy, "client-fingerprint", getStringFromMap(getMapFromMap(tls, "utls"), "fingerprint"))
+	if reality := getMapFromMap(tls, "reality"); getBoolFromMap(reality, "enabled") {
+		proxy["reality-opts"] = map[string]any{
+			"public-key": getStringFromMap(reality, "public_key"),
+			"short-id":   getStringFromMap(reality, "short_id"),
+		}
+	}
+}
+
+func transportClashParams(obj outboundMap, proxy map[string]any) {
+	transport := getMapFromMap(obj, "transport")
+	switch getStringFromMap(transport, "type") {
+	case C.V2RayTransportTypeWebsocket:
+		proxy["network"] = "ws"
+		opts := map[string]any{}
+		setClash(opts, "path", getStringFromMap(transport, "path"))
+		if host := getStringFromMap(getMapFromMap(transport, "headers"), "Host"); host != "" {
+			opts["headers"] = map[string]any{"Host": host}
+		}
+		proxy["ws-opts"] = opts
+	case C.V2RayTransportTypeGRPC:
+		proxy["network"] = "grpc"
+		proxy["grpc-opts"] = map[string]any{"grpc-service-name": getStringFromMap(transport, "service_name")}
+	case C.V2RayTransportTypeHTTP:
+		proxy["network"] = "h2"
+		opts := map[string]any{}
+		setClash(opts, "path", getStringFromMap(transport, "path"))
+		if host := getStringsFromMap(transport, "host"); len(host) > 0 {
+			opts["host"] = host
+		}
+		proxy["h2-opts"] = opts
+	case C.V2RayTransportTypeHTTPUpgrade:
+		proxy["network"] = "ws"
+		opts := map[string]any{"v2ray-http-upgrade": true}
+		setClash(opts, "path", getStringFromMap(transport, "path"))
+		if host := getStringFromMap(transport, "host"); host != "" {
+			opts["headers"] = map[string]any{"Host": host}
+		}
+		proxy["ws-opts"] = opts
+	}
+}
+
+// exportSingbox strips the ray2sing suffix from the tags. Names that would
+// collide are numbered, and detours are rewritten to the new tags.
+func exportSingbox(outbounds []outboundMap) (*ExportResult, error) {
+	renamed := map[string]string{}
+	used := map[string]bool{}
+	for _, obj := range outbounds {
+		name := exportName(obj)
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s %d", exportName(obj), i)
+		}
+		used[name] = true
+		renamed[getStringFromMap(obj, "tag")] = name
+	}
+	clean := make([]outboundMap, 0, len(outbounds))
+	for _, obj := range outbounds {
+		copied := outboundMap{}
+		for key, value := range obj {
+			copied[key] = value
+		}
+		copied["tag"] = renamed[getStringFromMap(obj, "tag")]
+		if detour, ok := renamed[getStringFromMap(obj, "detour")]; ok {
+			copied["detour"] = detour
+		}
+		clean = append(clean, copied)
+	}
+	content, err := json.MarshalIndent(map[string]any{"outbounds": clean}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &ExportResult{Content: string(content)}, nil
+}
+
+func setQuery(query url.Values, key string, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
+
+func setClash(proxy map[string]any, key string, value string) {
+	if value != "" {
+		proxy[key] = value
+	}
+}
+
+func getMapFromMap(m map[string]interface{}, key string) map[string]interface{} {
+	if val, ok := m[key].(map[string]interface{}); ok {
+		return val
+	}
+	return nil
+}
+
+func getBoolFromMap(m map[string]interface{}, key string) bool {
+	val, _ := m[key].(bool)
+	return val
+}
+
+func getIntFromMap(m map[string]interface{}, key string) int {
+	switch val := m[key].(type) {
+	case float64:
+		return int(val)
+	case int:
+		return val
+	case string:
+		n, _ := strconv.Atoi(val)
+		return n
+	}
+	return 0
+}
+
+func getIntsFromMap(m map[string]interface{}, key string) []int {
+	values, _ := m[key].([]interface{})
+	result := make([]int, 0, len(values))
+	for _, value := range values {
+		if n, ok := value.(float64); ok {
+			result = append(result, int(n))
+		}
+	}
+	return result
+}
+
+// getStringsFromMap reads a sing-box listable string, which is either a
+// single string or a list.
+func getStringsFromMap(m map[string]interface{}, key string) []string {
+	switch val := m[key].(type) {
+	case string:
+		if val == "" {
+			return nil
+		}
+		return []string{val}
+	case []interface{}:
+		result := make([]string, 0, len(val))
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
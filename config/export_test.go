@@ -0,0 +1,133 @@
+package config
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const exportTestConfig = `{
+	"outbounds": [
+		{"type": "selector", "tag": "select", "outbounds": ["vless § 0", "uap § 1"]},
+		{
+			"type": "vless", "tag": "vless § 0", "server": "example.com", "server_port": 443,
+			"uuid": "b831381d-6324-4d53-ad4f-8cda48b30811", "flow": "xtls-rprx-vision",
+			"tls": {
+				"enabled": true, "server_name": "www.example.com",
+				"utls": {"enabled": true, "fingerprint": "chrome"},
+				"reality": {"enabled": true, "public_key": "pbk", "short_id": "abcd"}
+			}
+		},
+		{
+			"type": "uap", "tag": "uap § 1", "server": "uap.example.com", "server_port": 8443,
+			"uuid": "00000000-0000-0000-0000-000000000000",
+			"tls": {"enabled": true, "server_name": "uap.example.com"},
+			"transport": {"type": "ws", "path": "/ws", "headers": {"Host": "cdn.example.com"}}
+		},
+		{
+			"type": "vmess", "tag": "vmess", "server": "1.2.3.4", "server_port": 80,
+			"uuid": "b831381d-6324-4d53-ad4f-8cda48b30811", "security": "auto",
+			"transport": {"type": "grpc", "service_name": "svc"}
+		},
+		{"type": "shadowsocks", "tag": "ss", "server": "5.6.7.8", "server_port": 8388, "method": "aes-128-gcm", "password": "pass"},
+		{"type": "direct", "tag": "direct"}
+	]
+}`
+
+func TestExportShareLinks(t *testing.T) {
+	result, err := ExportOutbounds([]byte(exportTestConfig), ExportFormatShareLink, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	links := strings.Split(result.Content, "\n")
+	if len(links) != 3 || len(result.Skipped) != 1 || result.Skipped[0] != "uap § 1" {
+		t.Fatalf("uap has no importable link and should be skipped: %+v", result)
+	}
+	if !strings.HasPrefix(links[0], "vless://b831381d-6324-4d53-ad4f-8cda48b30811@example.com:443?") ||
+		!strings.Contains(links[0], "security=reality") ||
+		!strings.Contains(links[0], "pbk=pbk") ||
+		!strings.HasSuffix(links[0], "#vless") {
+		t.Errorf("unexpected vless link: %s", links[0])
+	}
+
+	vmess, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(links[1], "vmess://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vmessValues map[string]string
+	if err := json.Unmarshal(vmess, &vmessValues); err != nil {
+		t.Fatal(err)
+	}
+	if vmessValues["net"] != "grpc" || vmessValues["path"] != "svc" || vmessValues["add"] != "1.2.3.4" {
+		t.Errorf("unexpected vmess link: %v", vmessValues)
+	}
+	if links[2] != "ss://"+base64.RawURLEncoding.EncodeToString([]byte("aes-128-gcm:pass"))+"@5.6.7.8:8388#ss" {
+		t.Errorf("unexpected ss link: %s", links[2])
+	}
+}
+
+func TestExportClash(t *testing.T) {
+	result, err := ExportOutbounds([]byte(exportTestConfig), ExportFormatClash, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0] != "uap § 1" {
+		t.Errorf("uap should be skipped for clash: %v", result.Skipped)
+	}
+	var clash struct {
+		Proxies []map[string]any `yaml:"proxies"`
+	}
+	if err := yaml.Unmarshal([]byte(result.Content), &clash); err != nil {
+		t.Fatal(err)
+	}
+	if len(clash.Proxies) != 3 {
+		t.Fatalf("unexpected proxies: %v", clash.Proxies)
+	}
+	if clash.Proxies[0]["name"] != "vless" || clash.Proxies[0]["servername"] != "www.example.com" {
+		t.Errorf("unexpected vless proxy: %v", clash.Proxies[0])
+	}
+}
+
+func TestExportSingboxTags(t *testing.T) {
+	result, err := ExportOutbounds([]byte(exportTestConfig), ExportFormatSingbox, []string{"uap"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config struct {
+		Outbounds []map[string]any `json:"outbounds"`
+	}
+	if err := json.Unmarshal([]byte(result.Content), &config); err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Outbounds) != 1 || config.Outbounds[0]["tag"] != "uap" || config.Outbounds[0]["type"] != "uap" {
+		t.Errorf("unexpected outbounds: %v", config.Outbounds)
+	}
+}
+
+func TestExportSingboxDuplicateNames(t *testing.T) {
+	content := `{
+		"outbounds": [
+			{"type": "shadowsocks", "tag": "ss § 0", "server": "1.1.1.1", "server_port": 8388, "method": "aes-128-gcm", "password": "a"},
+			{"type": "shadowsocks", "tag": "ss § 1", "server": "2.2.2.2", "server_port": 8388, "method": "aes-128-gcm", "password": "b", "detour": "ss § 0"}
+		]
+	}`
+	result, err := ExportOutbounds([]byte(content), ExportFormatSingbox, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config struct {
+		Outbounds []map[string]any `json:"outbounds"`
+	}
+	if err := json.Unmarshal([]byte(result.Content), &config); err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Outbounds) != 2 || config.Outbounds[0]["tag"] != "ss" || config.Outbounds[1]["tag"] != "ss 2" {
+		t.Fatalf("tags should stay unique: %v", config.Outbounds)
+	}
+	if config.Outbounds[1]["detour"] != "ss" {
+		t.Errorf("detour should follow the renamed tag: %v", config.Outbounds[1])
+	}
+}
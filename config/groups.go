@@ -0,0 +1,274 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"golang.org/x/net/publicsuffix"
+)
+
+const (
+	GroupTypeSelector    = "selector"
+	GroupTypeURLTest     = "url-test"
+	GroupTypeFallback    = "fallback"
+	GroupTypeLoadBalance = "load-balance"
+
+	BalanceRoundRobin     = "round-robin"
+	BalanceRandom         = "random"
+	BalanceConsistentHash = "consistent-hash"
+
+	groupDefaultInterval         = 30 * time.Second
+	groupDefaultFailureThreshold = 2
+	groupDefaultRecoveryDelay    = time.Minute
+)
+
+// OutboundGroup is a user defined group of proxy outbounds. Its members are
+// the listed outbounds followed by every proxy matching all of the given
+// filters; a group without filters and outbounds holds every proxy.
+type OutboundGroup struct {
+	Tag       string   `json:"tag"`
+	Type      string   `json:"type"`
+	TagRegex  string   `json:"tag-regex"`
+	Protocols []string `json:"protocols"`
+	Countries []string `json:"countries"`
+	Outbounds []string `json:"outbounds"`
+	// Strategy is the load-balance strategy: round-robin (default), random
+	// or consistent-hash. The member is picked for every new connection;
+	// consistent-hash keeps all connections with the same BalanceKey on one
+	// member.
+	Strategy string `json:"strategy"`
+
+	// Interval is the time between health checks of members.
+	Interval DurationInSeconds `json:"interval"`
+	// FailureThreshold is the number of failed checks in a row after which
+	// a fallback member is skipped.
+	FailureThreshold int `json:"failure-threshold"`
+	// RecoveryDelay is how long a preferred member has to stay healthy
+	// before a fallback group returns to it.
+	RecoveryDelay DurationInSeconds `json:"recovery-delay"`
+}
+
+func (g *OutboundGroup) CheckInterval() time.Duration {
+	if g.Interval <= 0 {
+		return groupDefaultInterval
+	}
+	return g.Interval.Duration()
+}
+
+func (g *OutboundGroup) MaxFailures() int {
+	if g.FailureThreshold <= 0 {
+		return groupDefaultFailureThreshold
+	}
+	return g.FailureThreshold
+}
+
+func (g *OutboundGroup) RecoveryDuration() time.Duration {
+	if g.RecoveryDelay <= 0 {
+		return groupDefaultRecoveryDelay
+	}
+	return g.RecoveryDelay.Duration()
+}
+
+// ManagedGroups returns the groups run by the core, see IsManaged,
+// including the auto group when it runs in fallback mode.
+func ManagedGroups(opt *HiddifyOptions) []OutboundGroup {
+	var groups []OutboundGroup
+	if opt.AutoGroupType == GroupTypeFallback {
+		groups = append(groups, OutboundGroup{Tag: OutboundURLTestTag, Type: GroupTypeFallback})
+	}
+	for _, group := range opt.Groups {
+		if group.IsManaged() {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+func (g *OutboundGroup) BalanceStrategy() string {
+	if g.Strategy == "" {
+		return BalanceRoundRobin
+	}
+	return g.Strategy
+}
+
+// BalanceKey is what a consistent-hash group hashes a connection by: the
+// registrable domain of its destination, so that every subdomain of a site
+// shares a member, or its destination address when it has no domain.
+func BalanceKey(domain string, address string) string {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domain == "" {
+		return address
+	}
+	if _, err := netip.ParseAddr(domain); err == nil {
+		return domain
+	}
+	if registrable, err := publicsuffix.EffectiveTLDPlusOne(domain); err == nil {
+		return registrable
+	}
+	return domain
+}
+
+// IsManaged reports whether the group is built as a selector run by the core:
+// it switches the selected member of a fallback group and replaces a
+// load-balance group with an outbound that picks a member per connection.
+func (g *OutboundGroup) IsManaged() bool {
+	return g.Type == GroupTypeFallback || g.Type == GroupTypeLoadBalance
+}
+
+func (g *OutboundGroup) hasFilters() bool {
+	return g.TagRegex != "" || len(g.Protocols) > 0 || len(g.Countries) > 0
+}
+
+// members returns the member tags of the group in order.
+func (g *OutboundGroup) members(proxies []option.Outbound, known map[string]bool) ([]string, error) {
+	var members []string
+	added := map[string]bool{}
+	add := func(tag string) {
+		if !added[tag] {
+			added[tag] = true
+			members = append(members, tag)
+		}
+	}
+	for _, tag := range g.Outbounds {
+		if known[tag] {
+			add(tag)
+		}
+	}
+	if !g.hasFilters() {
+		if len(g.Outbounds) == 0 {
+			for _, proxy := range proxies {
+				add(proxy.Tag)
+			}
+		}
+		return members, nil
+	}
+
+	var tagRegex *regexp.Regexp
+	if g.TagRegex != "" {
+		var err error
+		if tagRegex, err = regexp.Compile(g.TagRegex); err != nil {
+			return nil, fmt.Errorf("group %s: invalid tag regex: %w", g.Tag, err)
+		}
+	}
+	for _, proxy := range proxies {
+		if tagRegex != nil && !tagRegex.MatchString(proxy.Tag) {
+			continue
+		}
+		if len(g.Protocols) > 0 && !containsFold(g.Protocols, proxy.Type) {
+			continue
+		}
+		if len(g.Countries) > 0 && !containsFold(g.Countries, outboundCountry(proxy.Tag)) {
+			continue
+		}
+		add(proxy.Tag)
+	}
+	return members, nil
+}
+
+// reservedOutboundTags are the tags of the outbounds the builder always adds.
+var reservedOutboundTags = map[string]bool{
+	OutboundSelectTag: true, OutboundURLTestTag: true, OutboundDirectTag: true, OutboundBypassTag: true,
+	OutboundBlockTag: true, OutboundDNSTag: true, OutboundDirectFragmentTag: true,
+}
+
+// makeGroupOutbounds builds the outbounds of the user groups. Groups may list
+// proxies and earlier groups as members. A group left without members holds
+// only the block outbound, so rules targeting it never leak to direct.
+func makeGroupOutbounds(groups []OutboundGroup, proxies []option.Outbound, opt *HiddifyOptions) ([]option.Outbound, error) {
+	known := map[string]bool{}
+	for _, proxy := range proxies {
+		known[proxy.Tag] = true
+	}
+
+	var outbounds []option.Outbound
+	for _, group := range groups {
+		if group.Tag == "" {
+			return nil, fmt.Errorf("group without tag")
+		}
+		if known[group.Tag] || reservedOutboundTags[group.Tag] {
+			return nil, fmt.Errorf("group %s: tag already in use", group.Tag)
+		}
+		members, err := group.members(proxies, known)
+		if err != nil {
+			return nil, err
+		}
+		if len(members) == 0 {
+			members = []string{OutboundBlockTag}
+		}
+
+		outbound := option.Outbound{Tag: group.Tag}
+		switch group.Type {
+		case GroupTypeSelector, GroupTypeFallback, GroupTypeLoadBalance:
+			if group.Type == GroupTypeLoadBalance {
+				switch group.BalanceStrategy() {
+				case BalanceRoundRobin, BalanceRandom, BalanceConsistentHash:
+				default:
+					return nil, fmt.Errorf("group %s: unknown strategy %q", group.Tag, group.Strategy)
+				}
+			}
+			outbound.Type = C.TypeSelector
+			outbound.SelectorOptions = option.SelectorOutboundOptions{
+				Outbounds:                 members,
+				Default:                   members[0],
+				InterruptExistConnections: group.Type != GroupTypeLoadBalance,
+			}
+		case GroupTypeURLTest:
+			outbound.Type = C.TypeURLTest
+			outbound.URLTestOptions = option.URLTestOutboundOptions{
+				Outbounds:                 members,
+				URL:                       opt.ConnectionTestUrl,
+				Interval:                  option.Duration(opt.URLTestInterval.Duration()),
+				Tolerance:                 1,
+				IdleTimeout:               option.Duration(opt.URLTestInterval.Duration().Nanoseconds() * 3),
+				InterruptExistConnections: true,
+			}
+		default:
+			return nil, fmt.Errorf("group %s: unknown type %q", group.Tag, group.Type)
+		}
+		known[group.Tag] = true
+		outbounds = append(outbounds, outbound)
+	}
+	return outbounds, nil
+}
+
+// outboundCountry returns the ISO country code of the first flag emoji in
+// tag, or else the first two letter upper case word of tag.
+func outboundCountry(tag string) string {
+	runes := []rune(tag)
+	for i := 0; i+1 < len(runes); i++ {
+		if isRegionalIndicator(runes[i]) && isRegionalIndicator(runes[i+1]) {
+			return string([]rune{runes[i] - 0x1F1E6 + 'A', runes[i+1] - 0x1F1E6 + 'A'})
+		}
+	}
+	words := strings.FieldsFunc(tag, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if len(word) == 2 && word[0] >= 'A' && word[0] <= 'Z' && word[1] >= 'A' && word[1] <= 'Z' {
+			return word
+		}
+	}
+	return ""
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+func containsFold(list []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+var groupTestProxies = []option.Outbound{
+	{Type: C.TypeVLESS, Tag: "🇩🇪 Frankfurt § 0"},
+	{Type: C.TypeVMess, Tag: "🇳🇱 Amsterdam § 1"},
+	{Type: C.TypeHysteria2, Tag: "DE-hy2 § 2"},
+	{Type: C.TypeShadowsocks, Tag: "US ss § 3"},
+}
+
+func TestOutboundCountry(t *testing.T) {
+	for tag, country := range map[string]string{
+		"🇩🇪 Frankfurt":   "DE",
+		"[NL] node 1":    "NL",
+		"DE-hy2 § 2":     "DE",
+		"germany server": "",
+	} {
+		if got := outboundCountry(tag); got != country {
+			t.Errorf("outboundCountry(%q) = %q, want %q", tag, got, country)
+		}
+	}
+}
+
+func TestMakeGroupOutbounds(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	groups := []OutboundGroup{
+		{Tag: "EU servers", Type: GroupTypeURLTest, Countries: []string{"de", "nl"}},
+		{Tag: "Streaming", Type: GroupTypeFallback, Outbounds: []string{"US ss § 3", "EU servers", "missing"}, Protocols: []string{"hysteria2"}},
+		{Tag: "Bulk", Type: GroupTypeLoadBalance, TagRegex: "^nothing"},
+		{Tag: "All", Type: GroupTypeSelector},
+	}
+	outbounds, err := makeGroupOutbounds(groups, groupTestProxies, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outbounds) != 4 {
+		t.Fatalf("unexpected outbounds: %+v", outbounds)
+	}
+
+	eu := outbounds[0]
+	if eu.Type != C.TypeURLTest || len(eu.URLTestOptions.Outbounds) != 3 {
+		t.Errorf("unexpected EU group: %+v", eu.URLTestOptions)
+	}
+
+	streaming := outbounds[1].SelectorOptions
+	want := []string{"US ss § 3", "EU servers", "DE-hy2 § 2"}
+	if outbounds[1].Type != C.TypeSelector || len(streaming.Outbounds) != len(want) || streaming.Default != want[0] {
+		t.Fatalf("unexpected fallback group: %+v", streaming)
+	}
+	for i := range want {
+		if streaming.Outbounds[i] != want[i] {
+			t.Errorf("member %d = %q, want %q", i, streaming.Outbounds[i], want[i])
+		}
+	}
+
+	bulk := outbounds[2].SelectorOptions
+	if len(bulk.Outbounds) != 1 || bulk.Outbounds[0] != OutboundBlockTag || bulk.InterruptExistConnections {
+		t.Errorf("empty load-balance group should only hold block: %+v", bulk)
+	}
+	if len(outbounds[3].SelectorOptions.Outbounds) != len(groupTestProxies) {
+		t.Errorf("group without filters should hold every proxy: %+v", outbounds[3].SelectorOptions)
+	}
+}
+
+func TestMakeGroupOutboundsErrors(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	for _, groups := range [][]OutboundGroup{
+		{{Type: GroupTypeSelector}},
+		{{Tag: OutboundSelectTag, Type: GroupTypeSelector}},
+		{{Tag: "a", Type: "round-robin"}},
+		{{Tag: "a", Type: GroupTypeURLTest, TagRegex: "("}},
+	} {
+		if _, err := makeGroupOutbounds(groups, groupTestProxies, opt); err == nil {
+			t.Errorf("expected error for %+v", groups)
+		}
+	}
+}
+
+func TestManagedGroups(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	opt.AutoGroupType = GroupTypeFallback
+	opt.Groups = []OutboundGroup{
+		{Tag: "EU servers", Type: GroupTypeURLTest},
+		{Tag: "Streaming", Type: GroupTypeFallback, FailureThreshold: 5, RecoveryDelay: 10},
+	}
+	groups := ManagedGroups(opt)
+	if len(groups) != 2 || groups[0].Tag != OutboundURLTestTag || groups[1].Tag != "Streaming" {
+		t.Fatalf("unexpected managed groups: %+v", groups)
+	}
+	if groups[0].MaxFailures() != groupDefaultFailureThreshold || groups[0].CheckInterval() != groupDefaultInterval {
+		t.Errorf("auto group should use the defaults: %+v", groups[0])
+	}
+	if groups[1].MaxFailures() != 5 || groups[1].RecoveryDuration() != 10*time.Second {
+		t.Errorf("unexpected fallback settings: %+v", groups[1])
+	}
+}
+
+func TestSelectorDefault(t *testing.T) {
+	for _, test := range []struct {
+		selectorDefault string
+		want            string
+	}{
+		{"", OutboundURLTestTag},
+		{"US ss § 3", "US ss § 3"},
+		{"missing", OutboundURLTestTag},
+	} {
+		opt := DefaultHiddifyOptions()
+		opt.SelectorDefault = test.selectorDefault
+		var options option.Options
+		err := setOutbounds(&options, &option.Options{Outbounds: groupTestProxies}, opt, newBuild(BuildEnv{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if selector := options.Outbounds[0]; selector.Tag != OutboundSelectTag || selector.SelectorOptions.Default != test.want {
+			t.Errorf("%q: unexpected select group: %+v", test.selectorDefault, selector)
+		}
+	}
+}
+
+func TestBalanceKey(t *testing.T) {
+	for _, test := range []struct {
+		domain  string
+		address string
+		key     string
+	}{
+		{"www.example.com", "1.2.3.4", "example.com"},
+		{"CDN.Example.com.", "", "example.com"},
+		{"a.b.example.co.uk", "", "example.co.uk"},
+		{"localhost", "127.0.0.1", "localhost"},
+		{"", "1.2.3.4", "1.2.3.4"},
+		{"10.0.0.1", "", "10.0.0.1"},
+	} {
+		if key := BalanceKey(test.domain, test.address); key != test.key {
+			t.Errorf("BalanceKey(%q, %q) = %q, want %q", test.domain, test.address, key, test.key)
+		}
+	}
+
+	group := OutboundGroup{Tag: "Bulk", Type: GroupTypeLoadBalance, Strategy: BalanceConsistentHash}
+	outbounds, err := makeGroupOutbounds([]OutboundGroup{group}, groupTestProxies, DefaultHiddifyOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outbounds) != 1 || outbounds[0].SelectorOptions.InterruptExistConnections {
+		t.Errorf("unexpected consistent-hash group: %+v", outbounds)
+	}
+	group.Strategy = "least-load"
+	if _, err := makeGroupOutbounds([]OutboundGroup{group}, groupTestProxies, DefaultHiddifyOptions()); err == nil {
+		t.Error("expected error for unknown strategy")
+	}
+}
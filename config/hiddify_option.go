@@ -17,15 +17,18 @@ type HiddifyOptions struct {
 	UseXrayCoreWhenPossible bool   `json:"use-xray-core-when-possible"`
 	// GeoIPPath        string      `json:"geoip-path"`
 	// GeoSitePath      string      `json:"geosite-path"`
-	Rules     []Rule      `json:"rules"`
-	Warp      WarpOptions `json:"warp"`
-	Warp2     WarpOptions `json:"warp2"`
-	Mux       MuxOptions  `json:"mux"`
-	TLSTricks TLSTricks   `json:"tls-tricks"`
+	Rules     []Rule          `json:"rules"`
+	Groups    []OutboundGroup `json:"groups"`
+	Chains    []OutboundChain `json:"chains"`
+	Warp      WarpOptions     `json:"warp"`
+	Warp2     WarpOptions     `json:"warp2"`
+	Mux       MuxOptions      `json:"mux"`
+	TLSTricks TLSTricks       `json:"tls-tricks"`
 	DNSOptions
 	InboundOptions
 	URLTestOptions
 	RouteOptions
+	RuleSetOptions
 }
 
 type DNSOptions struct {
@@ -48,11 +51,25 @@ type InboundOptions struct {
 	MTU              uint32 `json:"mtu"`
 	StrictRoute      bool   `json:"strict-route"`
 	TUNStack         string `json:"tun-implementation"`
+	// TransparentProxy is tproxy or redirect to accept the traffic the
+	// firewall of a linux gateway sends to TProxyPort. The core applies
+	// TransparentProxyFirewall rules (auto, nftables, iptables or none) for it
+	// while it runs.
+	TransparentProxy         string `json:"transparent-proxy"`
+	TransparentProxyFirewall string `json:"transparent-proxy-firewall"`
 }
 
 type URLTestOptions struct {
 	ConnectionTestUrl string            `json:"connection-test-url"`
 	URLTestInterval   DurationInSeconds `json:"url-test-interval"`
+	// SeedURLTestFromHistory lets the auto group start from the stored health history.
+	SeedURLTestFromHistory bool `json:"url-test-seed-from-history"`
+	// AutoGroupType is url-test (default) or fallback, which keeps the auto
+	// group on the first healthy outbound instead of the fastest one.
+	AutoGroupType string `json:"auto-group-type"`
+	// SelectorDefault is the outbound the select group starts on, the auto
+	// group when empty or not a member.
+	SelectorDefault string `json:"selector-default"`
 	// URLTestIdleTimeout DurationInSeconds `json:"url-test-idle-timeout"`
 }
 
@@ -61,6 +78,7 @@ type RouteOptions struct {
 	IPv6Mode               option.DomainStrategy `json:"ipv6-mode"`
 	BypassLAN              bool                  `json:"bypass-lan"`
 	AllowConnectionFromLAN bool                  `json:"allow-connection-from-lan"`
+	LANShare               LANShareOptions       `json:"lan-share"`
 }
 
 type TLSTricks struct {
@@ -81,6 +99,7 @@ type MuxOptions struct {
 
 type WarpOptions struct {
 	Id                 string              `json:"id"`
+	VaultId            string              `json:"vault-id"` // vault account used instead of the wireguard config
 	EnableWarp         bool                `json:"enable"`
 	Mode               string              `json:"mode"`
 	WireguardConfigStr string              `json:"wireguard-config"`
@@ -106,18 +125,20 @@ func DefaultHiddifyOptions() *HiddifyOptions {
 			EnableDNSRouting:        false,
 		},
 		InboundOptions: InboundOptions{
-			EnableTun:      false,
-			SetSystemProxy: false,
-			MixedPort:      12334,
-			TProxyPort:     12335,
-			LocalDnsPort:   16450,
-			MTU:            9000,
-			StrictRoute:    true,
-			TUNStack:       "mixed",
+			EnableTun:                false,
+			SetSystemProxy:           false,
+			MixedPort:                12334,
+			TProxyPort:               12335,
+			LocalDnsPort:             16450,
+			MTU:                      9000,
+			StrictRoute:              true,
+			TUNStack:                 "mixed",
+			TransparentProxyFirewall: FirewallAuto,
 		},
 		URLTestOptions: URLTestOptions{
-			ConnectionTestUrl: "http://cp.cloudflare.com/",
-			URLTestInterval:   DurationInSeconds(600),
+			ConnectionTestUrl:      "http://cp.cloudflare.com/",
+			URLTestInterval:        DurationInSeconds(600),
+			SeedURLTestFromHistory: true,
 			// URLTestIdleTimeout: DurationInSeconds(6000),
 		},
 		RouteOptions: RouteOptions{
@@ -125,6 +146,14 @@ func DefaultHiddifyOptions() *HiddifyOptions {
 			IPv6Mode:               option.DomainStrategy(dns.DomainStrategyAsIS),
 			BypassLAN:              false,
 			AllowConnectionFromLAN: false,
+			LANShare: LANShareOptions{
+				Users:          []LANShareUser{},
+				AllowedClients: []string{},
+			},
+		},
+		RuleSetOptions: RuleSetOptions{
+			RuleSetDir:            "rule-sets",
+			RuleSetUpdateInterval: DurationInSeconds(5 * 24 * 60 * 60),
 		},
 		LogLevel: "warn",
 		// LogFile:        "/dev/null",
@@ -135,7 +164,9 @@ func DefaultHiddifyOptions() *HiddifyOptions {
 		ClashApiSecret: "",
 		// GeoIPPath:      "geoip.db",
 		// GeoSitePath:    "geosite.db",
-		Rules: []Rule{},
+		Rules:  []Rule{},
+		Groups: []OutboundGroup{},
+		Chains: []OutboundChain{},
 		Mux: MuxOptions{
 			Enable:     false,
 			Padding:    true,
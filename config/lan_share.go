@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/auth"
+)
+
+// LANShareOptions restrict who can use the proxy when
+// AllowConnectionFromLAN is set.
+type LANShareOptions struct {
+	// Users have to authenticate to the mixed inbound, local apps included.
+	Users []LANShareUser `json:"users"`
+	// AllowedClients are the addresses or CIDRs of the devices allowed to
+	// connect; every device is allowed when empty.
+	AllowedClients []string `json:"allowed-clients"`
+}
+
+type LANShareUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// LANInboundTags are the inbounds that accept connections from the LAN.
+var LANInboundTags = []string{InboundMixedTag, InboundDNSTag, InboundTProxyTag, InboundRedirectTag}
+
+func validateLANShare(opt *HiddifyOptions) error {
+	seen := map[string]bool{}
+	for _, user := range opt.LANShare.Users {
+		if user.Username == "" {
+			return fmt.Errorf("lan share user without username")
+		}
+		if seen[user.Username] {
+			return fmt.Errorf("lan share user %s is duplicated", user.Username)
+		}
+		seen[user.Username] = true
+	}
+	if len(opt.LANShare.Users) > 0 && opt.AllowConnectionFromLAN && opt.SetSystemProxy {
+		return fmt.Errorf("the system proxy can not authenticate to lan share users")
+	}
+	_, err := lanShareClientPrefixes(opt)
+	return err
+}
+
+func lanShareUsers(opt *HiddifyOptions) []auth.User {
+	if !opt.AllowConnectionFromLAN {
+		return nil
+	}
+	users := make([]auth.User, 0, len(opt.LANShare.Users))
+	for _, user := range opt.LANShare.Users {
+		users = append(users, auth.User{Username: user.Username, Password: user.Password})
+	}
+	return users
+}
+
+func lanShareClientPrefixes(opt *HiddifyOptions) ([]string, error) {
+	var prefixes []string
+	for _, client := range opt.LANShare.AllowedClients {
+		if prefix, err := netip.ParsePrefix(client); err == nil {
+			prefixes = append(prefixes, prefix.Masked().String())
+			continue
+		}
+		addr, err := netip.ParseAddr(client)
+		if err != nil {
+			return nil, fmt.Errorf("invalid lan share client %s", client)
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()).String())
+	}
+	return prefixes, nil
+}
+
+// makeLANShareRule blocks connections to the LAN inbounds from clients that
+// are not allowed. The host itself is always allowed.
+func makeLANShareRule(opt *HiddifyOptions) (option.Rule, bool) {
+	prefixes, err := lanShareClientPrefixes(opt)
+	if err != nil || len(prefixes) == 0 || !opt.AllowConnectionFromLAN {
+		return option.Rule{}, false
+	}
+	prefixes = append(prefixes, "127.0.0.0/8", "::1/128")
+	return option.Rule{
+		Type: C.RuleTypeLogical,
+		LogicalOptions: option.LogicalRule{
+			Mode: RuleModeAnd,
+			Rules: []option.DefaultRule{
+				{Inbound: LANInboundTags},
+				{SourceIPCIDR: prefixes, Invert: true},
+			},
+			Outbound: OutboundBlockTag,
+		},
+	}, true
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+)
+
+func TestLANShare(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	opt.LANShare = LANShareOptions{
+		Users:          []LANShareUser{{Username: "alice", Password: "secret"}},
+		AllowedClients: []string{"192.168.1.0/24", "10.0.0.7"},
+	}
+	if users := lanShareUsers(opt); len(users) != 0 {
+		t.Errorf("users should only apply when sharing with the LAN: %+v", users)
+	}
+	if _, ok := makeLANShareRule(opt); ok {
+		t.Error("allow-list should only apply when sharing with the LAN")
+	}
+
+	opt.AllowConnectionFromLAN = true
+	if err := validateLANShare(opt); err != nil {
+		t.Fatal(err)
+	}
+	if users := lanShareUsers(opt); len(users) != 1 || users[0].Username != "alice" {
+		t.Errorf("unexpected users: %+v", users)
+	}
+	rule, ok := makeLANShareRule(opt)
+	if !ok || rule.Type != C.RuleTypeLogical || rule.LogicalOptions.Outbound != OutboundBlockTag {
+		t.Fatalf("unexpected rule: %+v", rule)
+	}
+	clients := rule.LogicalOptions.Rules[1]
+	if !clients.Invert || len(clients.SourceIPCIDR) != 4 || clients.SourceIPCIDR[1] != "10.0.0.7/32" {
+		t.Errorf("unexpected client condition: %+v", clients)
+	}
+
+	opt.SetSystemProxy = true
+	if err := validateLANShare(opt); err == nil {
+		t.Error("expected error for system proxy with users")
+	}
+	opt.SetSystemProxy = false
+	opt.LANShare.AllowedClients = []string{"office"}
+	if err := validateLANShare(opt); err == nil {
+		t.Error("expected error for invalid client")
+	}
+	opt.LANShare = LANShareOptions{Users: []LANShareUser{{Username: "a"}, {Username: "a"}}}
+	if err := validateLANShare(opt); err == nil {
+		t.Error("expected error for duplicated user")
+	}
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+type ChangeScope int
+
+const (
+	// ChangeScopeLive can be applied to the running service.
+	ChangeScopeLive ChangeScope = iota
+	// ChangeScopeNextStart is used the next time a config is built.
+	ChangeScopeNextStart
+	// ChangeScopeRestart changes the inbounds or the tun of the running
+	// config, which need new listeners, so the core is restarted.
+	ChangeScopeRestart
+)
+
+func (s ChangeScope) String() string {
+	switch s {
+	case ChangeScopeLive:
+		return "live"
+	case ChangeScopeRestart:
+		return "restart"
+	default:
+		return "next-start"
+	}
+}
+
+type OptionChange struct {
+	Key   string // json path, e.g. "log-level" or "warp.mode"
+	Scope ChangeScope
+}
+
+var optionScopes = map[string]ChangeScope{
+	"log-level":         ChangeScopeLive,
+	"rules":             ChangeScopeLive,
+	"url-test-interval": ChangeScopeLive,
+	"selector-default":  ChangeScopeLive,
+
+	"enable-tun":                 ChangeScopeRestart,
+	"enable-tun-service":         ChangeScopeRestart,
+	"set-system-proxy":           ChangeScopeRestart,
+	"mixed-port":                 ChangeScopeRestart,
+	"tproxy-port":                ChangeScopeRestart,
+	"local-dns-port":             ChangeScopeRestart,
+	"mtu":                        ChangeScopeRestart,
+	"strict-route":               ChangeScopeRestart,
+	"tun-implementation":         ChangeScopeRestart,
+	"transparent-proxy":          ChangeScopeRestart,
+	"transparent-proxy-firewall": ChangeScopeRestart,
+	"allow-connection-from-lan":  ChangeScopeRestart,
+	"lan-share":                  ChangeScopeRestart,
+}
+
+// optionScope looks up the full path of a change first, so a nested option
+// such as "lan-share.users" can have its own scope, and then its top level
+// key.
+func optionScope(topKey string, key string) ChangeScope {
+	for _, lookup := range []string{key, topKey} {
+		if scope, ok := optionScopes[lookup]; ok {
+			return scope
+		}
+	}
+	// Everything else, from outbounds to dns, is built into a config that
+	// the running core keeps until it starts again.
+	return ChangeScopeNextStart
+}
+
+// DiffHiddifyOptions lists the settings that differ between from and to,
+// sorted by key. Nested objects are compared field by field, everything else
+// (including lists such as rules) as a whole.
+func DiffHiddifyOptions(from, to *HiddifyOptions) ([]OptionChange, error) {
+	oldMap, err := optionsToMap(from)
+	if err != nil {
+		return nil, err
+	}
+	newMap, err := optionsToMap(to)
+	if err != nil {
+		return nil, err
+	}
+	var changes []OptionChange
+	diffOptionMaps("", oldMap, newMap, func(topKey string, key string) {
+		changes = append(changes, OptionChange{Key: key, Scope: optionScope(topKey, key)})
+	})
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes, nil
+}
+
+// NeedsRestart reports whether any of the changes requires a restart.
+func NeedsRestart(changes []OptionChange) bool {
+	for _, change := range changes {
+		if change.Scope == ChangeScopeRestart {
+			return true
+		}
+	}
+	return false
+}
+
+func optionsToMap(options *HiddifyOptions) (map[string]any, error) {
+	if options == nil {
+		options = DefaultHiddifyOptions()
+	}
+	content, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]any
+	if err := json.Unmarshal(content, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func diffOptionMaps(prefix string, from, to map[string]any, onChange func(topKey string, key string)) {
+	keys := map[string]bool{}
+	for key := range from {
+		keys[key] = true
+	}
+	for key := range to {
+		keys[key] = true
+	}
+	for key := range keys {
+		path := key
+		topKey := key
+		if prefix != "" {
+			path = prefix + "." + key
+			topKey = prefix
+		}
+		oldChild, oldIsMap := from[key].(map[string]any)
+		newChild, newIsMap := to[key].(map[string]any)
+		if oldIsMap && newIsMap {
+			diffOptionMaps(path, oldChild, newChild, func(_ string, key string) {
+				onChange(topKey, key)
+			})
+			continue
+		}
+		if !reflect.DeepEqual(from[key], to[key]) {
+			onChange(topKey, path)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package config
+
+import "testing"
+
+func TestDiffHiddifyOptions(t *testing.T) {
+	from := DefaultHiddifyOptions()
+	to := DefaultHiddifyOptions()
+	to.LogLevel = "debug"
+	to.Rules = []Rule{{Domains: "example.com", Outbound: "bypass"}}
+	to.Warp.Mode = "warp_over_proxy"
+	to.SeedURLTestFromHistory = false
+
+	changes, err := DiffHiddifyOptions(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]ChangeScope{
+		"log-level":                  ChangeScopeLive,
+		"rules":                      ChangeScopeLive,
+		"warp.mode":                  ChangeScopeNextStart,
+		"url-test-seed-from-history": ChangeScopeNextStart,
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("unexpected changes: %+v", changes)
+	}
+	for _, change := range changes {
+		if scope, ok := want[change.Key]; !ok || scope != change.Scope {
+			t.Errorf("unexpected change %s (%s)", change.Key, change.Scope)
+		}
+	}
+	if NeedsRestart(changes) {
+		t.Error("only inbound and tun changes need a restart")
+	}
+}
+
+func TestDiffHiddifyOptionsLiveOnly(t *testing.T) {
+	from := DefaultHiddifyOptions()
+	to := DefaultHiddifyOptions()
+	to.LogLevel = "debug"
+	to.URLTestInterval = DurationInSeconds(60)
+	to.SelectorDefault = "proxy"
+
+	changes, err := DiffHiddifyOptions(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 3 || NeedsRestart(changes) {
+		t.Fatalf("expected no restart for %+v", changes)
+	}
+	for _, change := range changes {
+		if change.Scope != ChangeScopeLive {
+			t.Errorf("%s should be applied live", change.Key)
+		}
+	}
+}
+
+func TestDiffHiddifyOptionsInbound(t *testing.T) {
+	from := DefaultHiddifyOptions()
+	to := DefaultHiddifyOptions()
+	to.MixedPort = 2080
+	to.EnableTun = true
+
+	changes, err := DiffHiddifyOptions(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 || !NeedsRestart(changes) {
+		t.Fatalf("expected a restart for %+v", changes)
+	}
+	for _, change := range changes {
+		if change.Scope != ChangeScopeRestart {
+			t.Errorf("%s should need a restart", change.Key)
+		}
+	}
+}
+
+func TestDiffHiddifyOptionsTransparentProxy(t *testing.T) {
+	from := DefaultHiddifyOptions()
+	to := DefaultHiddifyOptions()
+	to.TransparentProxyFirewall = FirewallIPTables
+
+	changes, err := DiffHiddifyOptions(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Key != "transparent-proxy-firewall" || changes[0].Scope != ChangeScopeRestart {
+		t.Fatalf("the firewall rules are applied on start and need a restart: %+v", changes)
+	}
+}
+
+func TestDiffHiddifyOptionsLANShare(t *testing.T) {
+	from := DefaultHiddifyOptions()
+	to := DefaultHiddifyOptions()
+	to.LANShare.Users = []LANShareUser{{Username: "guest", Password: "secret"}}
+	to.LANShare.AllowedClients = []string{"192.168.1.0/24"}
+
+	changes, err := DiffHiddifyOptions(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{"lan-share.allowed-clients": true, "lan-share.users": true}
+	if len(changes) != len(want) {
+		t.Fatalf("unexpected changes: %+v", changes)
+	}
+	for _, change := range changes {
+		if !want[change.Key] || change.Scope != ChangeScopeRestart {
+			t.Errorf("unexpected change %s (%s)", change.Key, change.Scope)
+		}
+	}
+}
+
+func TestOptionScopeNestedKey(t *testing.T) {
+	optionScopes["lan-share.users"] = ChangeScopeNextStart
+	defer delete(optionScopes, "lan-share.users")
+	if scope := optionScope("lan-share", "lan-share.users"); scope != ChangeScopeNextStart {
+		t.Errorf("the full path should be looked up, got %s", scope)
+	}
+	if scope := optionScope("lan-share", "lan-share.allowed-clients"); scope != ChangeScopeRestart {
+		t.Errorf("other lan-share keys should restart, got %s", scope)
+	}
+}
@@ -183,11 +183,11 @@ func isOutboundRealityFromMap(obj outboundMap) bool {
 	return false
 }
 
-func patchOutbound(base option.Outbound, configOpt HiddifyOptions, staticIpsDns map[string][]string) (*option.Outbound, string, error) {
+func patchOutbound(base option.Outbound, configOpt HiddifyOptions, staticIpsDns map[string][]string, b *build) (*option.Outbound, string, error) {
 	formatErr := func(err error) error {
 		return fmt.Errorf("error patching outbound[%s][%s]: %w", base.Tag, base.Type, err)
 	}
-	err := patchWarp(&base, &configOpt, true, staticIpsDns)
+	err := patchWarp(&base, &configOpt, true, staticIpsDns, b)
 	if err != nil {
 		return nil, "", formatErr(err)
 	}
@@ -0,0 +1,247 @@
+package config
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hiddify/ray2sing/ray2sing"
+	"github.com/sagernet/sing-box/option"
+)
+
+// ParseAttempt is the reason a single parser rejected the config.
+type ParseAttempt struct {
+	Parser    string
+	Err       error
+	Line      int // 1-based line in the content, 0 when unknown
+	LinkIndex int // 1-based index of the failing link, 0 when not a link
+	LinkCount int
+}
+
+func (a ParseAttempt) String() string {
+	var location []string
+	if a.LinkIndex > 0 {
+		location = append(location, fmt.Sprintf("link %d of %d", a.LinkIndex, a.LinkCount))
+	}
+	if a.Line > 0 {
+		location = append(location, fmt.Sprintf("line %d", a.Line))
+	}
+	if len(location) == 0 {
+		return fmt.Sprintf("[%s] %v", a.Parser, a.Err)
+	}
+	return fmt.Sprintf("[%s] %s: %v", a.Parser, strings.Join(location, ", "), a.Err)
+}
+
+// ParseError is returned by ParseConfigContent when no parser accepts the
+// content. It keeps the reason of every parser that was tried.
+type ParseError struct {
+	Attempts []ParseAttempt
+}
+
+func (e *ParseError) Error() string {
+	parts := make([]string, 0, len(e.Attempts))
+	for _, attempt := range e.Attempts {
+		parts = append(parts, attempt.String())
+	}
+	return "unable to determine config format: " + strings.Join(parts, "; ")
+}
+
+func (e *ParseError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Attempts))
+	for _, attempt := range e.Attempts {
+		errs = append(errs, attempt.Err)
+	}
+	return errs
+}
+
+func jsonParseAttempt(content []byte, err error) ParseAttempt {
+	attempt := ParseAttempt{Parser: "SingboxParser", Err: err}
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		attempt.Line = lineAtOffset(content, syntaxErr.Offset)
+	case errors.As(err, &typeErr):
+		attempt.Line = lineAtOffset(content, typeErr.Offset)
+	}
+	return attempt
+}
+
+// singboxJsonFormatError tells why decoded json can not be a sing-box
+// config or outbound.
+func singboxJsonFormatError(value any) error {
+	switch value.(type) {
+	case map[string]interface{}, []map[string]interface{}:
+		return nil
+	case []interface{}:
+		return fmt.Errorf("incorrect json format: expected an object, got an array")
+	case string:
+		return fmt.Errorf("incorrect json format: expected an object, got a string")
+	case nil:
+		return fmt.Errorf("incorrect json format: expected an object, got null")
+	default:
+		return fmt.Errorf("incorrect json format: expected an object, got %T", value)
+	}
+}
+
+func lineAtOffset(content []byte, offset int64) int {
+	if offset <= 0 {
+		return 1
+	}
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	return bytes.Count(content[:offset], []byte("\n")) + 1
+}
+
+var yamlLineRegex = regexp.MustCompile(`line (\d+)`)
+
+func yamlParseAttempt(err error) ParseAttempt {
+	attempt := ParseAttempt{Parser: "ClashParser", Err: err}
+	if match := yamlLineRegex.FindStringSubmatch(err.Error()); match != nil {
+		attempt.Line, _ = strconv.Atoi(match[1])
+	}
+	return attempt
+}
+
+// linkParsers are the ray2sing parsers of the known schemes. ray2sing
+// decides whether a link is accepted but only logs the reason it dropped
+// one, so the parser of the scheme is run again to tell why.
+var linkParsers = []struct {
+	prefix string
+	parse  ray2sing.ParserFunc
+	xray   ray2sing.ParserFunc
+}{
+	{"vmess://", ray2sing.VmessSingbox, ray2sing.VmessXray},
+	{"vless://", ray2sing.VlessSingbox, ray2sing.VlessXray},
+	{"trojan://", ray2sing.TrojanSingbox, ray2sing.TrojanXray},
+	{"svmess://", ray2sing.VmessSingbox, nil},
+	{"svless://", ray2sing.VlessSingbox, nil},
+	{"strojan://", ray2sing.TrojanSingbox, nil},
+	{"ss://", ray2sing.ShadowsocksSingbox, nil},
+	{"tuic://", ray2sing.TuicSingbox, nil},
+	{"hysteria://", ray2sing.HysteriaSingbox, nil},
+	{"hysteria2://", ray2sing.Hysteria2Singbox, nil},
+	{"hy2://", ray2sing.Hysteria2Singbox, nil},
+	{"ssh://", ray2sing.SSHSingbox, nil},
+	{"wg://", ray2sing.WiregaurdSingbox, nil},
+	{"wiregaurd://", ray2sing.WiregaurdSingbox, nil},
+	{"ssconf://", ray2sing.BeepassSingbox, nil},
+	{"warp://", ray2sing.WarpSingbox, nil},
+	{"direct://", ray2sing.DirectSingbox, ray2sing.DirectXray},
+	{"socks://", ray2sing.SocksSingbox, nil},
+	{"phttp://", ray2sing.HttpSingbox, nil},
+	{"phttps://", ray2sing.HttpsSingbox, nil},
+	{"http://", ray2sing.HttpSingbox, nil},
+	{"https://", ray2sing.HttpsSingbox, nil},
+	{"xvmess://", ray2sing.VmessXray, nil},
+	{"xvless://", ray2sing.VlessXray, nil},
+	{"xtrojan://", ray2sing.TrojanXray, nil},
+	{"xdirect://", ray2sing.DirectXray, nil},
+}
+
+type configLink struct {
+	Line int
+	Link string
+}
+
+// splitLinks returns the lines ray2sing would treat as links.
+func splitLinks(content string) []configLink {
+	content = decodeBase64Links(content)
+	var links []configLink
+	for i, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if len(line) < 5 || line[0] == '#' || line[0] == '/' {
+			continue
+		}
+		links = append(links, configLink{Line: i + 1, Link: line})
+	}
+	return links
+}
+
+func decodeBase64Links(content string) string {
+	trimmed := strings.TrimSpace(content)
+	if padding := len(trimmed) % 4; padding != 0 {
+		trimmed += "===="[:4-padding]
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(trimmed); err == nil {
+		return string(decoded)
+	}
+	return content
+}
+
+// parseLink converts a single link, including `&&detour=` chains, and
+// returns the outbound of the last element.
+func parseLink(link string, useXray bool) (outbound *option.Outbound, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outbound = nil
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	for _, chain := range strings.Split(link, "&&detour=") {
+		outbound, err = parseSingleLink(chain, useXray)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return outbound, nil
+}
+
+func parseSingleLink(link string, useXray bool) (*option.Outbound, error) {
+	converted, convertErr := ray2sing.Ray2Singbox(link, useXray)
+	if convertErr == nil {
+		var options option.Options
+		if err := json.Unmarshal([]byte(converted), &options); err != nil {
+			return nil, err
+		}
+		if len(options.Outbounds) == 0 {
+			return nil, fmt.Errorf("empty outbound")
+		}
+		return &options.Outbounds[len(options.Outbounds)-1], nil
+	}
+	for _, parser := range linkParsers {
+		if !strings.HasPrefix(link, parser.prefix) {
+			continue
+		}
+		parse := parser.parse
+		if parser.xray != nil && (useXray || strings.Contains(link, "&core=xray")) {
+			parse = parser.xray
+		}
+		if _, err := parse(link); err != nil {
+			return nil, err
+		}
+		break
+	}
+	scheme, _, found := strings.Cut(link, "://")
+	if !found {
+		return nil, fmt.Errorf("not a link")
+	}
+	return nil, fmt.Errorf("%s link: %w", scheme, convertErr)
+}
+
+// linkParseAttempts reports every link in the content that can not be
+// converted. It falls back to err when no single link can be blamed.
+func linkParseAttempts(content string, useXray bool, err error) []ParseAttempt {
+	links := splitLinks(content)
+	var attempts []ParseAttempt
+	for i, link := range links {
+		if _, linkErr := parseLink(link.Link, useXray); linkErr != nil {
+			attempts = append(attempts, ParseAttempt{
+				Parser:    "V2rayParser",
+				Err:       linkErr,
+				Line:      link.Line,
+				LinkIndex: i + 1,
+				LinkCount: len(links),
+			})
+		}
+	}
+	if len(attempts) == 0 {
+		attempts = append(attempts, ParseAttempt{Parser: "V2rayParser", Err: err})
+	}
+	return attempts
+}
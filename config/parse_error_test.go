@@ -0,0 +1,65 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseErrorJsonLine(t *testing.T) {
+	content := "{\n  \"outbounds\": [\n    {\"type\": \"direct\",}\n  ]\n}"
+	_, err := ParseConfigContent(content, false, nil, false)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected ParseError, got %v", err)
+	}
+	if parseErr.Attempts[0].Parser != "SingboxParser" || parseErr.Attempts[0].Line != 3 {
+		t.Errorf("unexpected json attempt: %+v", parseErr.Attempts[0])
+	}
+}
+
+func TestParseErrorLinkIndex(t *testing.T) {
+	content := strings.Join([]string{
+		"#profile-title: test",
+		"foo://not-supported",
+		"unknown://example.com:443",
+	}, "\n")
+	attempts := linkParseAttempts(content, false, errors.New("No outbounds found"))
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %+v", attempts)
+	}
+	second := attempts[1]
+	if second.LinkIndex != 2 || second.LinkCount != 2 || second.Line != 3 {
+		t.Errorf("unexpected location: %+v", second)
+	}
+	if !strings.Contains(second.String(), "link 2 of 2") {
+		t.Errorf("unexpected message: %s", second)
+	}
+	if !strings.Contains(attempts[0].Err.Error(), "foo link") {
+		t.Errorf("an unknown scheme should still be reported per link: %v", attempts[0].Err)
+	}
+}
+
+func TestParseErrorJsonNotObject(t *testing.T) {
+	_, err := ParseConfigContent("[1, 2]", false, nil, false)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected ParseError, got %v", err)
+	}
+	first := parseErr.Attempts[0]
+	if first.Parser != "SingboxParser" || !strings.Contains(first.Err.Error(), "array") {
+		t.Errorf("unexpected json attempt: %+v", first)
+	}
+}
+
+func TestParseErrorClashWithoutProxies(t *testing.T) {
+	_, err := ParseConfigContent("proxies: []\n", false, nil, false)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected ParseError, got %v", err)
+	}
+	last := parseErr.Attempts[len(parseErr.Attempts)-1]
+	if last.Parser != "ClashParser" || !strings.Contains(last.Err.Error(), "no proxies") {
+		t.Errorf("unexpected clash attempt: %+v", last)
+	}
+}
@@ -45,17 +45,44 @@ func ParseConfigContentToOptions(contentstr string, debug bool, configOpt *Hiddi
 	return &options, nil
 }
 
+// ParseConfigContentToOptionsWithoutWarp is like ParseConfigContentToOptions
+// but leaves warp outbounds as they are, so parsing never registers a warp
+// device; building the config registers them.
+func ParseConfigContentToOptionsWithoutWarp(contentstr string, configOpt *HiddifyOptions) (*option.Options, error) {
+	content, err := parseConfigContent(contentstr, false, configOpt, false, false)
+	if err != nil {
+		return nil, err
+	}
+	var options option.Options
+	if err := json.Unmarshal(content, &options); err != nil {
+		return nil, err
+	}
+	return &options, nil
+}
+
 func ParseConfigContent(contentstr string, debug bool, configOpt *HiddifyOptions, fullConfig bool) ([]byte, error) {
+	return parseConfigContent(contentstr, debug, configOpt, fullConfig, true)
+}
+
+// parseConfigContent leaves warp outbounds as they are when withWarp is
+// false, so that parsing never registers a warp device.
+func parseConfigContent(contentstr string, debug bool, configOpt *HiddifyOptions, fullConfig bool, withWarp bool) ([]byte, error) {
 	if configOpt == nil {
 		configOpt = DefaultHiddifyOptions()
 	}
 	content := []byte(contentstr)
 	var jsonObj map[string]interface{} = make(map[string]interface{})
 
-	fmt.Printf("Convert using json\n")
+	var attempts []ParseAttempt
+
 	var tmpJsonResult any
 	jsonDecoder := json.NewDecoder(SJ.NewCommentFilter(bytes.NewReader(content)))
-	if err := jsonDecoder.Decode(&tmpJsonResult); err == nil {
+	if err := jsonDecoder.Decode(&tmpJsonResult); err != nil {
+		attempts = append(attempts, jsonParseAttempt(content, err))
+	} else if err := singboxJsonFormatError(tmpJsonResult); err != nil {
+		// valid json, but not a config; other formats may still accept it
+		attempts = append(attempts, ParseAttempt{Parser: "SingboxParser", Err: err, Line: 1})
+	} else {
 		if tmpJsonObj, ok := tmpJsonResult.(map[string]interface{}); ok {
 			if tmpJsonObj["outbounds"] == nil {
 				jsonObj["outbounds"] = []interface{}{jsonObj}
@@ -68,41 +95,39 @@ func ParseConfigContent(contentstr string, debug bool, configOpt *HiddifyOptions
 			}
 		} else if jsonArray, ok := tmpJsonResult.([]map[string]interface{}); ok {
 			jsonObj["outbounds"] = jsonArray
-		} else {
-			return nil, fmt.Errorf("[SingboxParser] Incorrect Json Format")
 		}
 
 		newContent, _ := json.MarshalIndent(jsonObj, "", "  ")
 
-		return patchConfig(newContent, "SingboxParser", configOpt)
+		return patchConfig(newContent, "SingboxParser", configOpt, withWarp)
 	}
 
 	v2rayStr, err := ray2sing.Ray2Singbox(string(content), configOpt.UseXrayCoreWhenPossible)
 	if err == nil {
-		return patchConfig([]byte(v2rayStr), "V2rayParser", configOpt)
+		return patchConfig([]byte(v2rayStr), "V2rayParser", configOpt, withWarp)
 	}
-	fmt.Printf("Convert using clash\n")
+	attempts = append(attempts, linkParseAttempts(string(content), configOpt.UseXrayCoreWhenPossible, err)...)
+
 	clashObj := clash.Clash{}
-	if err := yaml.Unmarshal(content, &clashObj); err == nil && clashObj.Proxies != nil {
-		if len(clashObj.Proxies) == 0 {
-			return nil, fmt.Errorf("[ClashParser] no outbounds found")
-		}
-		converted, err := convert.Clash2sing(clashObj)
-		if err != nil {
-			return nil, fmt.Errorf("[ClashParser] converting clash to sing-box error: %w", err)
-		}
+	if err := yaml.Unmarshal(content, &clashObj); err != nil {
+		attempts = append(attempts, yamlParseAttempt(err))
+	} else if len(clashObj.Proxies) == 0 {
+		attempts = append(attempts, ParseAttempt{Parser: "ClashParser", Err: fmt.Errorf("no proxies found")})
+	} else if converted, err := convert.Clash2sing(clashObj); err != nil {
+		attempts = append(attempts, ParseAttempt{Parser: "ClashParser", Err: fmt.Errorf("converting clash to sing-box error: %w", err)})
+	} else {
 		output := configByte
 		output, err = convert.Patch(output, converted, "", "", nil)
 		if err != nil {
 			return nil, fmt.Errorf("[ClashParser] patching clash config error: %w", err)
 		}
-		return patchConfig(output, "ClashParser", configOpt)
+		return patchConfig(output, "ClashParser", configOpt, withWarp)
 	}
 
-	return nil, fmt.Errorf("unable to determine config format")
+	return nil, &ParseError{Attempts: attempts}
 }
 
-func patchConfig(content []byte, name string, configOpt *HiddifyOptions) ([]byte, error) {
+func patchConfig(content []byte, name string, configOpt *HiddifyOptions, withWarp bool) ([]byte, error) {
 	var jsonObj map[string]interface{}
 	err := json.Unmarshal(content, &jsonObj)
 	if err != nil {
@@ -172,22 +197,24 @@ func patchConfig(content []byte, name string, configOpt *HiddifyOptions) ([]byte
 		}
 
 		// Process regular outbounds
-		b, _ := batch.New(context.Background(), batch.WithConcurrencyNum[*option.Outbound](2))
-		for _, base := range options.Outbounds {
-			out := base
-			b.Go(base.Tag, func() (*option.Outbound, error) {
-				err := patchWarp(&out, configOpt, false, nil)
-				if err != nil {
-					return nil, fmt.Errorf("[Warp] patch warp error: %w", err)
+		if withWarp {
+			b, _ := batch.New(context.Background(), batch.WithConcurrencyNum[*option.Outbound](2))
+			for _, base := range options.Outbounds {
+				out := base
+				b.Go(base.Tag, func() (*option.Outbound, error) {
+					err := patchWarp(&out, configOpt, false, nil, nil)
+					if err != nil {
+						return nil, fmt.Errorf("[Warp] patch warp error: %w", err)
+					}
+					return &out, nil
+				})
+			}
+			if res, err := b.WaitAndGetResult(); err != nil {
+				return nil, err
+			} else {
+				for i, base := range options.Outbounds {
+					options.Outbounds[i] = *res[base.Tag].Value
 				}
-				return &out, nil
-			})
-		}
-		if res, err := b.WaitAndGetResult(); err != nil {
-			return nil, err
-		} else {
-			for i, base := range options.Outbounds {
-				options.Outbounds[i] = *res[base.Tag].Value
 			}
 		}
 	}
@@ -237,7 +264,6 @@ func patchConfig(content []byte, name string, configOpt *HiddifyOptions) ([]byte
 		}
 	}
 
-	fmt.Printf("%s\n", content)
 	return validateResult(content, name)
 }
 
@@ -0,0 +1,262 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hiddify/ray2sing/ray2sing"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/experimental/libbox"
+	SJ "github.com/sagernet/sing/common/json"
+	"github.com/xmdhs/clash2singbox/convert"
+	"github.com/xmdhs/clash2singbox/model/clash"
+	"gopkg.in/yaml.v3"
+)
+
+// SkippedEntry is a link or outbound dropped by ParseConfigContentLenient.
+type SkippedEntry struct {
+	Index  int // 1-based position of the link or outbound
+	Line   int // 1-based line of the link, 0 otherwise
+	Tag    string
+	Parser string
+	Err    error
+}
+
+type ParseReport struct {
+	Parser  string
+	Total   int
+	Skipped []SkippedEntry
+}
+
+func (r *ParseReport) skip(entry SkippedEntry) {
+	entry.Parser = r.Parser
+	r.Skipped = append(r.Skipped, entry)
+}
+
+func (r *ParseReport) noValidEntries() error {
+	attempts := make([]ParseAttempt, 0, len(r.Skipped))
+	for _, entry := range r.Skipped {
+		attempt := ParseAttempt{Parser: entry.Parser, Err: entry.Err, Line: entry.Line}
+		if entry.Line > 0 {
+			attempt.LinkIndex = entry.Index
+			attempt.LinkCount = r.Total
+		}
+		attempts = append(attempts, attempt)
+	}
+	if len(attempts) == 0 {
+		attempts = append(attempts, ParseAttempt{Parser: r.Parser, Err: fmt.Errorf("no outbounds found")})
+	}
+	return &ParseError{Attempts: attempts}
+}
+
+// ParseConfigContentLenient is like ParseConfigContent but checks every link
+// or outbound on its own and drops the ones that do not pass
+// libbox.CheckConfig instead of failing the whole config. It only fails when
+// nothing valid is left.
+func ParseConfigContentLenient(contentstr string, debug bool, configOpt *HiddifyOptions, fullConfig bool) ([]byte, *ParseReport, error) {
+	if configOpt == nil {
+		configOpt = DefaultHiddifyOptions()
+	}
+	content := []byte(contentstr)
+
+	var jsonObj map[string]interface{}
+	jsonDecoder := json.NewDecoder(SJ.NewCommentFilter(bytes.NewReader(content)))
+	if err := jsonDecoder.Decode(&jsonObj); err == nil {
+		outbounds, ok := jsonObj["outbounds"].([]interface{})
+		if !ok {
+			result, err := ParseConfigContent(contentstr, debug, configOpt, fullConfig)
+			return result, &ParseReport{Parser: "SingboxParser", Total: 1}, err
+		}
+		kept, report := filterJsonOutbounds(outbounds)
+		if len(kept) == 0 {
+			return nil, report, report.noValidEntries()
+		}
+		jsonObj["outbounds"] = kept
+		newContent, err := json.Marshal(jsonObj)
+		if err != nil {
+			return nil, report, err
+		}
+		result, err := ParseConfigContent(string(newContent), debug, configOpt, fullConfig)
+		return result, report, err
+	}
+
+	// Links come before Clash, in the same order as ParseConfigContent.
+	links := splitLinks(contentstr)
+	report := &ParseReport{Parser: "V2rayParser", Total: len(links)}
+	var kept []string
+	for i, link := range links {
+		if err := checkLink(link.Link, configOpt.UseXrayCoreWhenPossible); err != nil {
+			report.skip(SkippedEntry{Index: i + 1, Line: link.Line, Tag: linkName(link.Link), Err: err})
+			continue
+		}
+		kept = append(kept, link.Link)
+	}
+	if len(kept) > 0 {
+		result, err := ParseConfigContent(strings.Join(kept, "\n"), debug, configOpt, fullConfig)
+		return result, report, err
+	}
+
+	clashObj := clash.Clash{}
+	if err := yaml.Unmarshal(content, &clashObj); err == nil && len(clashObj.Proxies) > 0 {
+		return parseClashLenient(clashObj, configOpt)
+	}
+	return nil, report, report.noValidEntries()
+}
+
+func checkLink(link string, useXray bool) error {
+	if _, err := parseLink(link, useXray); err != nil {
+		return err
+	}
+	converted, err := ray2sing.Ray2Singbox(link, useXray)
+	if err != nil {
+		return err
+	}
+	return libbox.CheckConfig(converted)
+}
+
+func linkName(link string) string {
+	_, fragment, found := strings.Cut(link, "#")
+	if !found {
+		return ""
+	}
+	if name, err := url.PathUnescape(fragment); err == nil {
+		return name
+	}
+	return fragment
+}
+
+func parseClashLenient(clashObj clash.Clash, configOpt *HiddifyOptions) ([]byte, *ParseReport, error) {
+	report := &ParseReport{Parser: "ClashParser", Total: len(clashObj.Proxies)}
+	kept := clashObj.Proxies[:0:0]
+	for i := range clashObj.Proxies {
+		single := clashObj
+		single.Proxies = clashObj.Proxies[i : i+1]
+		output, err := convertClash(single)
+		if err == nil {
+			err = libbox.CheckConfig(string(output))
+		}
+		if err != nil {
+			report.skip(SkippedEntry{Index: i + 1, Err: err})
+			continue
+		}
+		kept = append(kept, clashObj.Proxies[i])
+	}
+	if len(kept) == 0 {
+		return nil, report, report.noValidEntries()
+	}
+	clashObj.Proxies = kept
+	output, err := convertClash(clashObj)
+	if err != nil {
+		return nil, report, err
+	}
+	result, err := patchConfig(output, "ClashParser", configOpt, true)
+	return result, report, err
+}
+
+func convertClash(clashObj clash.Clash) ([]byte, error) {
+	converted, err := convert.Clash2sing(clashObj)
+	if err != nil {
+		return nil, fmt.Errorf("[ClashParser] converting clash to sing-box error: %w", err)
+	}
+	output, err := convert.Patch(configByte, converted, "", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("[ClashParser] patching clash config error: %w", err)
+	}
+	return output, nil
+}
+
+// filterJsonOutbounds checks each outbound together with its detours. Groups
+// are checked last and lose the members that were dropped.
+func filterJsonOutbounds(outbounds []interface{}) ([]interface{}, *ParseReport) {
+	report := &ParseReport{Parser: "SingboxParser", Total: len(outbounds)}
+	byTag := map[string]outboundMap{}
+	for _, outbound := range outbounds {
+		if obj, ok := outbound.(map[string]interface{}); ok {
+			byTag[getStringFromMap(obj, "tag")] = obj
+		}
+	}
+
+	valid := map[string]bool{}
+	var groups []int
+	for i, outbound := range outbounds {
+		obj, ok := outbound.(map[string]interface{})
+		if !ok {
+			report.skip(SkippedEntry{Index: i + 1, Err: fmt.Errorf("outbound is not an object")})
+			continue
+		}
+		switch getStringFromMap(obj, "type") {
+		case C.TypeSelector, C.TypeURLTest:
+			groups = append(groups, i)
+			continue
+		}
+		if err := checkOutbounds(withDetours(obj, byTag)); err != nil {
+			report.skip(SkippedEntry{Index: i + 1, Tag: getStringFromMap(obj, "tag"), Err: err})
+			continue
+		}
+		valid[getStringFromMap(obj, "tag")] = true
+	}
+	for _, i := range groups {
+		valid[getStringFromMap(outbounds[i].(map[string]interface{}), "tag")] = true
+	}
+
+	var kept []interface{}
+	for i, outbound := range outbounds {
+		obj, ok := outbound.(map[string]interface{})
+		if !ok || !valid[getStringFromMap(obj, "tag")] {
+			continue
+		}
+		switch getStringFromMap(obj, "type") {
+		case C.TypeSelector, C.TypeURLTest:
+			if err := filterGroupMembers(obj, valid); err != nil {
+				report.skip(SkippedEntry{Index: i + 1, Tag: getStringFromMap(obj, "tag"), Err: err})
+				continue
+			}
+		}
+		kept = append(kept, obj)
+	}
+	return kept, report
+}
+
+func withDetours(obj outboundMap, byTag map[string]outboundMap) []interface{} {
+	result := []interface{}{obj}
+	seen := map[string]bool{getStringFromMap(obj, "tag"): true}
+	for detour := getStringFromMap(obj, "detour"); detour != "" && !seen[detour]; {
+		seen[detour] = true
+		next, ok := byTag[detour]
+		if !ok {
+			break
+		}
+		result = append(result, next)
+		detour = getStringFromMap(next, "detour")
+	}
+	return result
+}
+
+func checkOutbounds(outbounds []interface{}) error {
+	content, err := json.Marshal(map[string]interface{}{"outbounds": outbounds})
+	if err != nil {
+		return err
+	}
+	return libbox.CheckConfig(string(content))
+}
+
+func filterGroupMembers(group outboundMap, valid map[string]bool) error {
+	members, _ := group["outbounds"].([]interface{})
+	var kept []interface{}
+	for _, member := range members {
+		if tag, ok := member.(string); ok && valid[tag] {
+			kept = append(kept, tag)
+		}
+	}
+	if len(kept) == 0 {
+		return fmt.Errorf("no valid outbounds left in group")
+	}
+	group["outbounds"] = kept
+	if defaultTag := getStringFromMap(group, "default"); defaultTag != "" && !valid[defaultTag] {
+		delete(group, "default")
+	}
+	return nil
+}
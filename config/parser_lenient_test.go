@@ -0,0 +1,78 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestFilterJsonOutbounds(t *testing.T) {
+	var outbounds []interface{}
+	err := json.Unmarshal([]byte(`[
+		{"type": "selector", "tag": "select", "outbounds": ["ok", "broken"], "default": "broken"},
+		{"type": "direct", "tag": "ok"},
+		{"type": "not-a-protocol", "tag": "broken"}
+	]`), &outbounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kept, report := filterJsonOutbounds(outbounds)
+	if len(kept) != 2 {
+		t.Fatalf("expected selector and direct to be kept, got %v", kept)
+	}
+	if len(report.Skipped) != 1 || report.Skipped[0].Tag != "broken" || report.Skipped[0].Index != 3 {
+		t.Fatalf("unexpected report: %+v", report.Skipped)
+	}
+	selector := kept[0].(map[string]interface{})
+	if members := selector["outbounds"].([]interface{}); len(members) != 1 || members[0] != "ok" {
+		t.Errorf("broken member should be removed from group: %v", members)
+	}
+	if _, ok := selector["default"]; ok {
+		t.Error("default pointing to a skipped outbound should be removed")
+	}
+}
+
+func TestLinkName(t *testing.T) {
+	if name := linkName("vless://id@example.com:443?security=tls#My%20Server"); name != "My Server" {
+		t.Errorf("unexpected name %q", name)
+	}
+	if name := linkName("ss://abc@example.com:443"); name != "" {
+		t.Errorf("unexpected name %q", name)
+	}
+}
+
+func TestParseConfigContentLenientLinks(t *testing.T) {
+	content := strings.Join([]string{
+		"vless://b831381d-6324-4d53-ad4f-8cda48b30811@example.com:443?security=tls&type=tcp#good",
+		"foo://not-supported",
+		"vmess://not-base64#broken",
+	}, "\n")
+	result, report, err := ParseConfigContentLenient(content, false, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Parser != "V2rayParser" || report.Total != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if len(report.Skipped) != 2 || report.Skipped[0].Index != 2 || report.Skipped[1].Index != 3 || report.Skipped[1].Tag != "broken" {
+		t.Fatalf("unexpected skipped links: %+v", report.Skipped)
+	}
+	var parsed struct {
+		Outbounds []struct {
+			Type string `json:"type"`
+		} `json:"outbounds"`
+	}
+	if err := json.Unmarshal(result, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	vless := 0
+	for _, outbound := range parsed.Outbounds {
+		if outbound.Type == "vless" {
+			vless++
+		}
+	}
+	if vless != 1 {
+		t.Errorf("expected the good link to be kept, got %s", result)
+	}
+}
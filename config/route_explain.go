@@ -0,0 +1,729 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sagernet/sing-box/common/srs"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/domain"
+	N "github.com/sagernet/sing/common/network"
+)
+
+const (
+	// RuleOriginRule is a rule of HiddifyOptions.Rules.
+	RuleOriginRule           = "rule"
+	RuleOriginRegion         = "region"
+	RuleOriginBlockAds       = "block-ads"
+	RuleOriginBypassLAN      = "bypass-lan"
+	RuleOriginLANShare       = "lan-share"
+	RuleOriginConnectionTest = "connection-test"
+	// RuleOriginBuiltIn is a rule every config has, like the dns hijack.
+	RuleOriginBuiltIn = "built-in"
+)
+
+// RuleOrigin tells which option a route or dns rule was built from. Rule and
+// Index are only set for RuleOriginRule.
+type RuleOrigin struct {
+	Kind        string `json:"kind"`
+	Description string `json:"description,omitempty"`
+	Index       int    `json:"index"`
+	Rule        *Rule  `json:"rule,omitempty"`
+}
+
+// ruleOrigins records the origin of each rule by its index in the route and
+// dns rules of the built config. Rules that were not recorded are built-in. A
+// nil ruleOrigins records nothing.
+type ruleOrigins struct {
+	route []RuleOrigin
+	dns   []RuleOrigin
+}
+
+func (o *ruleOrigins) addRoute(origin RuleOrigin, count int) {
+	if o == nil {
+		return
+	}
+	for range count {
+		o.route = append(o.route, origin)
+	}
+}
+
+// insertDNS records origin for the dns rule inserted at index at; dns rules
+// are also prepended, which moves the ones recorded before.
+func (o *ruleOrigins) insertDNS(at int, origin RuleOrigin) {
+	if o == nil {
+		return
+	}
+	for len(o.dns) < at {
+		o.dns = append(o.dns, RuleOrigin{Kind: RuleOriginBuiltIn})
+	}
+	o.dns = slices.Insert(o.dns, at, origin)
+}
+
+func (o *ruleOrigins) routeOrigin(index int) RuleOrigin {
+	if index < len(o.route) {
+		return o.route[index]
+	}
+	return RuleOrigin{Kind: RuleOriginBuiltIn}
+}
+
+func (o *ruleOrigins) dnsOrigin(index int) RuleOrigin {
+	if index < len(o.dns) {
+		return o.dns[index]
+	}
+	return RuleOrigin{Kind: RuleOriginBuiltIn}
+}
+
+// TrafficKey names the traffic of the route rule at index; it stays the
+// same across builds while the rule keeps its place in HiddifyOptions.Rules,
+// unlike the rule itself whose rule-set tags and ips may change.
+func (o RuleOrigin) TrafficKey(index int) string {
+	switch o.Kind {
+	case RuleOriginRule:
+		return fmt.Sprintf("%s-%d", RuleOriginRule, o.Index)
+	case RuleOriginBuiltIn, "":
+		return fmt.Sprintf("route-%d", index)
+	default:
+		return o.Kind
+	}
+}
+
+// BuildConfigWithRuleOrigins is BuildConfig that also returns the origin of
+// each route rule of the built config.
+func BuildConfigWithRuleOrigins(opt HiddifyOptions, input option.Options) (*option.Options, []RuleOrigin, error) {
+	b := newBuild(DefaultBuildEnv())
+	b.origins = &ruleOrigins{}
+	options, err := buildConfig(opt, input, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	var origins []RuleOrigin
+	if options.Route != nil {
+		for i := range options.Route.Rules {
+			origins = append(origins, b.origins.routeOrigin(i))
+		}
+	}
+	return options, origins, nil
+}
+
+// RouteRequest is the connection ExplainRoute evaluates. Domain or IP is
+// required; Network defaults to tcp, Inbound to the mixed inbound and
+// SourceIP to the loopback address. Process is a process name or path.
+type RouteRequest struct {
+	Domain      string `json:"domain,omitempty"`
+	IP          string `json:"ip,omitempty"`
+	Port        uint16 `json:"port,omitempty"`
+	Network     string `json:"network,omitempty"`
+	Protocol    string `json:"protocol,omitempty"`
+	Inbound     string `json:"inbound,omitempty"`
+	SourceIP    string `json:"source-ip,omitempty"`
+	Process     string `json:"process,omitempty"`
+	PackageName string `json:"package-name,omitempty"`
+}
+
+// MatchedRule is the rule a connection matched; Rule is its sing-box json.
+type MatchedRule struct {
+	Index  int        `json:"index"`
+	Origin RuleOrigin `json:"origin"`
+	Rule   string     `json:"rule"`
+}
+
+// RouteExplanation is how the config routes a RouteRequest. A nil rule means
+// the final server or outbound was used. OutboundChain follows the outbound
+// through the default of each selector.
+type RouteExplanation struct {
+	DNSServer     string       `json:"dns-server,omitempty"`
+	DNSRule       *MatchedRule `json:"dns-rule,omitempty"`
+	RouteRule     *MatchedRule `json:"route-rule,omitempty"`
+	Outbound      string       `json:"outbound"`
+	OutboundChain []string     `json:"outbound-chain"`
+	OutboundType  string       `json:"outbound-type"`
+	Warnings      []string     `json:"warnings,omitempty"`
+}
+
+var placeholderWarpConfig = WarpWireguardConfig{LocalAddressIPv4: "172.16.0.2", LocalAddressIPv6: "fd01::2"}
+
+// ExplainRoute builds the config of opt and input and evaluates request
+// against its dns and route rules. Nothing is resolved or downloaded: rule
+// sets are read from RuleSetDir or their local path, and ip rules only match
+// when request has an ip.
+func ExplainRoute(opt HiddifyOptions, input option.Options, request RouteRequest) (*RouteExplanation, error) {
+	metadata, err := newRouteMetadata(request)
+	if err != nil {
+		return nil, err
+	}
+	// an empty static prober and placeholder warp accounts keep the build
+	// offline; warp outbounds are only routed to, so their keys do not matter
+	b := newBuild(BuildEnv{Prober: StaticProber{}, Warp: StaticWarpAccounts{Config: placeholderWarpConfig}})
+	b.origins = &ruleOrigins{}
+	options, err := buildConfig(opt, input, b)
+	if err != nil {
+		return nil, err
+	}
+	return explainRoute(&opt, options, b.origins, metadata), nil
+}
+
+func explainRoute(opt *HiddifyOptions, options *option.Options, origins *ruleOrigins, metadata routeMetadata) *RouteExplanation {
+	explainer := &routeExplainer{
+		opt:      opt,
+		metadata: metadata,
+		ruleSets: map[string]option.RuleSet{},
+		loaded:   map[string]*option.PlainRuleSet{},
+		warned:   map[string]bool{},
+	}
+	for _, ruleSet := range options.Route.RuleSet {
+		explainer.ruleSets[ruleSet.Tag] = ruleSet
+	}
+
+	var explanation RouteExplanation
+	if metadata.domain != "" && options.DNS != nil {
+		explanation.DNSServer = options.DNS.Final
+		for i, rule := range options.DNS.Rules {
+			if !explainer.matchDNSRule(rule) {
+				continue
+			}
+			content, _ := json.Marshal(rule)
+			explanation.DNSRule = &MatchedRule{Index: i, Origin: origins.dnsOrigin(i), Rule: string(content)}
+			explanation.DNSServer = dnsRuleServer(rule)
+			break
+		}
+	}
+
+	explanation.Outbound = options.Route.Final
+	for i, rule := range options.Route.Rules {
+		if !explainer.matchRule(rule) {
+			continue
+		}
+		content, _ := json.Marshal(rule)
+		explanation.RouteRule = &MatchedRule{Index: i, Origin: origins.routeOrigin(i), Rule: string(content)}
+		explanation.Outbound = routeRuleOutbound(rule)
+		break
+	}
+	explanation.OutboundChain, explanation.OutboundType = outboundChain(options.Outbounds, explanation.Outbound)
+	if metadata.domain != "" && !metadata.addr.IsValid() && explainer.skippedIPRules {
+		explainer.warn("ip rules were not evaluated as the domain is not resolved offline; pass its ip")
+	}
+	explanation.Warnings = explainer.warnings
+	return &explanation
+}
+
+func dnsRuleServer(rule option.DNSRule) string {
+	if rule.Type == C.RuleTypeLogical {
+		return rule.LogicalOptions.Server
+	}
+	return rule.DefaultOptions.Server
+}
+
+func routeRuleOutbound(rule option.Rule) string {
+	if rule.Type == C.RuleTypeLogical {
+		return rule.LogicalOptions.Outbound
+	}
+	return rule.DefaultOptions.Outbound
+}
+
+// outboundChain follows tag through the defaults of selectors; the members
+// of an urltest group are only known while running.
+func outboundChain(outbounds []option.Outbound, tag string) ([]string, string) {
+	byTag := make(map[string]option.Outbound, len(outbounds))
+	for _, outbound := range outbounds {
+		byTag[outbound.Tag] = outbound
+	}
+	chain := []string{tag}
+	seen := map[string]bool{tag: true}
+	for {
+		outbound, ok := byTag[tag]
+		if !ok {
+			return chain, ""
+		}
+		if outbound.Type != C.TypeSelector {
+			return chain, outbound.Type
+		}
+		next := outbound.SelectorOptions.Default
+		if next == "" && len(outbound.SelectorOptions.Outbounds) > 0 {
+			next = outbound.SelectorOptions.Outbounds[0]
+		}
+		if next == "" || seen[next] {
+			return chain, outbound.Type
+		}
+		seen[next] = true
+		chain = append(chain, next)
+		tag = next
+	}
+}
+
+type routeMetadata struct {
+	domain      string
+	addr        netip.Addr
+	port        uint16
+	network     string
+	protocol    string
+	inbound     string
+	source      netip.Addr
+	processName string
+	processPath string
+	packageName string
+}
+
+func newRouteMetadata(request RouteRequest) (routeMetadata, error) {
+	metadata := routeMetadata{
+		domain:      strings.ToLower(strings.TrimSuffix(strings.TrimSpace(request.Domain), ".")),
+		port:        request.Port,
+		network:     strings.ToLower(request.Network),
+		protocol:    strings.ToLower(request.Protocol),
+		inbound:     request.Inbound,
+		packageName: request.PackageName,
+	}
+	if request.IP != "" {
+		addr, err := netip.ParseAddr(request.IP)
+		if err != nil {
+			return metadata, fmt.Errorf("invalid ip %s", request.IP)
+		}
+		metadata.addr = addr.Unmap()
+	}
+	if metadata.domain == "" && !metadata.addr.IsValid() {
+		return metadata, fmt.Errorf("either a domain or an ip is required")
+	}
+	switch metadata.network {
+	case "":
+		metadata.network = N.NetworkTCP
+	case N.NetworkTCP, N.NetworkUDP:
+	default:
+		return metadata, fmt.Errorf("unknown network %s", request.Network)
+	}
+	if metadata.inbound == "" {
+		metadata.inbound = InboundMixedTag
+	}
+	metadata.source = netip.MustParseAddr("127.0.0.1")
+	if request.SourceIP != "" {
+		source, err := netip.ParseAddr(request.SourceIP)
+		if err != nil {
+			return metadata, fmt.Errorf("invalid source ip %s", request.SourceIP)
+		}
+		metadata.source = source.Unmap()
+	}
+	if request.Process != "" {
+		if strings.ContainsAny(request.Process, `/\`) {
+			metadata.processPath = request.Process
+			metadata.processName = filepath.Base(strings.ReplaceAll(request.Process, `\`, "/"))
+		} else {
+			metadata.processName = request.Process
+		}
+	}
+	return metadata, nil
+}
+
+// ruleItems are the conditions of a route, dns or rule-set rule. Like in
+// sing-box, conditions on the destination address, the destination port,
+// the source address and the source port match if any of them does, and
+// every other condition has to match.
+type ruleItems struct {
+	inbound         []string
+	network         []string
+	protocol        []string
+	domain          []string
+	domainSuffix    []string
+	domainKeyword   []string
+	domainRegex     []string
+	domainMatcher   *domain.Matcher
+	ipCIDR          []string
+	ipSet           func(netip.Addr) bool
+	ipIsPrivate     bool
+	sourceIPCIDR    []string
+	sourceIPSet     func(netip.Addr) bool
+	port            []uint16
+	portRange       []string
+	sourcePort      []uint16
+	sourcePortRange []string
+	processName     []string
+	processPath     []string
+	packageName     []string
+	ruleSet         []string
+	outbound        []string
+	// geo databases are not supported offline
+	geo    []string
+	invert bool
+}
+
+type routeExplainer struct {
+	opt            *HiddifyOptions
+	metadata       routeMetadata
+	ruleSets       map[string]option.RuleSet
+	loaded         map[string]*option.PlainRuleSet
+	warned         map[string]bool
+	warnings       []string
+	skippedIPRules bool
+}
+
+func (e *routeExplainer) warn(message string) {
+	if !e.warned[message] {
+		e.warned[message] = true
+		e.warnings = append(e.warnings, message)
+	}
+}
+
+func (e *routeExplainer) matchRule(rule option.Rule) bool {
+	if rule.Type != C.RuleTypeLogical {
+		return e.match(defaultRuleItems(rule.DefaultOptions))
+	}
+	items := make([]ruleItems, 0, len(rule.LogicalOptions.Rules))
+	for _, sub := range rule.LogicalOptions.Rules {
+		items = append(items, defaultRuleItems(sub))
+	}
+	return e.matchLogical(rule.LogicalOptions.Mode, rule.LogicalOptions.Invert, items)
+}
+
+func (e *routeExplainer) matchDNSRule(rule option.DNSRule) bool {
+	if rule.Type != C.RuleTypeLogical {
+		return e.match(defaultDNSRuleItems(rule.DefaultOptions))
+	}
+	items := make([]ruleItems, 0, len(rule.LogicalOptions.Rules))
+	for _, sub := range rule.LogicalOptions.Rules {
+		items = append(items, defaultDNSRuleItems(sub))
+	}
+	return e.matchLogical(rule.LogicalOptions.Mode, rule.LogicalOptions.Invert, items)
+}
+
+func (e *routeExplainer) matchHeadlessRule(rule option.HeadlessRule) bool {
+	if rule.Type != C.RuleTypeLogical {
+		return e.match(headlessRuleItems(rule.DefaultOptions))
+	}
+	matched := rule.LogicalOptions.Mode == RuleModeAnd
+	for _, sub := range rule.LogicalOptions.Rules {
+		if e.matchHeadlessRule(sub) != matched {
+			matched = !matched
+			break
+		}
+	}
+	return matched != rule.LogicalOptions.Invert
+}
+
+func (e *routeExplainer) matchLogical(mode string, invert bool, rules []ruleItems) bool {
+	matched := mode == RuleModeAnd
+	for _, items := range rules {
+		if e.match(items) != matched {
+			matched = !matched
+			break
+		}
+	}
+	return matched != invert
+}
+
+func (e *routeExplainer) match(items ruleItems) bool {
+	return e.matchItems(items) != items.invert
+}
+
+func (e *routeExplainer) matchItems(items ruleItems) bool {
+	m := e.metadata
+	if len(items.inbound) > 0 && !containsString(items.inbound, m.inbound) {
+		return false
+	}
+	if len(items.network) > 0 && !containsString(items.network, m.network) {
+		return false
+	}
+	if len(items.protocol) > 0 && !containsString(items.protocol, m.protocol) {
+		return false
+	}
+	if len(items.processName) > 0 && !containsString(items.processName, m.processName) {
+		return false
+	}
+	if len(items.processPath) > 0 && !containsString(items.processPath, m.processPath) {
+		return false
+	}
+	if len(items.packageName) > 0 && !containsString(items.packageName, m.packageName) {
+		return false
+	}
+	// dns queries made for an outbound are not explained
+	if len(items.outbound) > 0 {
+		return false
+	}
+	if len(items.ruleSet) > 0 && !e.matchRuleSets(items.ruleSet) {
+		return false
+	}
+	if len(items.sourceIPCIDR) > 0 || items.sourceIPSet != nil {
+		if !matchAddr(m.source, items.sourceIPCIDR, items.sourceIPSet) {
+			return false
+		}
+	}
+	// the source port of a connection is random
+	if len(items.sourcePort) > 0 || len(items.sourcePortRange) > 0 {
+		return false
+	}
+	if len(items.port) > 0 || len(items.portRange) > 0 {
+		if !matchPort(m.port, items.port, items.portRange) {
+			return false
+		}
+	}
+	if items.hasDestinationAddress() && !e.matchDestination(items) {
+		return false
+	}
+	return true
+}
+
+func (items ruleItems) hasDestinationAddress() bool {
+	return len(items.domain) > 0 || len(items.domainSuffix) > 0 || len(items.domainKeyword) > 0 ||
+		len(items.domainRegex) > 0 || items.domainMatcher != nil || len(items.ipCIDR) > 0 ||
+		items.ipSet != nil || items.ipIsPrivate || len(items.geo) > 0
+}
+
+func (e *routeExplainer) matchDestination(items ruleItems) bool {
+	m := e.metadata
+	for _, geo := range items.geo {
+		e.warn(geo + " is not evaluated offline; use a rule-set")
+	}
+	if m.domain != "" {
+		if items.domainMatcher != nil && items.domainMatcher.Match(m.domain) {
+			return true
+		}
+		if len(items.domain) > 0 || len(items.domainSuffix) > 0 {
+			if domain.NewMatcher(items.domain, nonEmpty(items.domainSuffix)).Match(m.domain) {
+				return true
+			}
+		}
+		for _, keyword := range items.domainKeyword {
+			if strings.Contains(m.domain, keyword) {
+				return true
+			}
+		}
+		for _, expression := range items.domainRegex {
+			if matched, err := regexp.MatchString(expression, m.domain); err == nil && matched {
+				return true
+			}
+		}
+	}
+	hasIP := len(items.ipCIDR) > 0 || items.ipSet != nil || items.ipIsPrivate
+	if !m.addr.IsValid() {
+		if hasIP {
+			e.skippedIPRules = true
+		}
+		return false
+	}
+	if items.ipIsPrivate && isPrivateAddr(m.addr) {
+		return true
+	}
+	return matchAddr(m.addr, items.ipCIDR, items.ipSet)
+}
+
+func (e *routeExplainer) matchRuleSets(tags []string) bool {
+	for _, tag := range tags {
+		ruleSet := e.loadRuleSet(tag)
+		if ruleSet == nil {
+			continue
+		}
+		for _, rule := range ruleSet.Rules {
+			if e.matchHeadlessRule(rule) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loadRuleSet reads a rule-set of the config from its local path or from
+// RuleSetDir, where remote rule-sets are cached.
+func (e *routeExplainer) loadRuleSet(tag string) *option.PlainRuleSet {
+	if ruleSet, ok := e.loaded[tag]; ok {
+		return ruleSet
+	}
+	e.loaded[tag] = nil
+	ruleSet, ok := e.ruleSets[tag]
+	if !ok {
+		e.warn("rule-set " + tag + " is not defined")
+		return nil
+	}
+	path := ruleSet.LocalOptions.Path
+	if ruleSet.Type != C.RuleSetTypeLocal {
+		path = filepath.Join(e.opt.RuleSetDir, RuleSetSource{Tag: tag, Format: ruleSet.Format}.FileName())
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		e.warn("rule-set " + tag + " is not cached; update the rule-sets to evaluate it")
+		return nil
+	}
+	plain, err := decodeRuleSet(content, ruleSet.Format)
+	if err != nil {
+		e.warn("rule-set " + tag + ": " + err.Error())
+		return nil
+	}
+	e.loaded[tag] = &plain
+	return &plain
+}
+
+func decodeRuleSet(content []byte, format string) (option.PlainRuleSet, error) {
+	if format == C.RuleSetFormatSource {
+		var compat option.PlainRuleSetCompat
+		err := json.Unmarshal(content, &compat)
+		return compat.Options, err
+	}
+	return srs.Read(bytes.NewReader(content), false)
+}
+
+func defaultRuleItems(r option.DefaultRule) ruleItems {
+	return ruleItems{
+		inbound:         r.Inbound,
+		network:         r.Network,
+		protocol:        r.Protocol,
+		domain:          r.Domain,
+		domainSuffix:    r.DomainSuffix,
+		domainKeyword:   r.DomainKeyword,
+		domainRegex:     r.DomainRegex,
+		ipCIDR:          r.IPCIDR,
+		ipIsPrivate:     r.IPIsPrivate,
+		sourceIPCIDR:    r.SourceIPCIDR,
+		port:            r.Port,
+		portRange:       r.PortRange,
+		sourcePort:      r.SourcePort,
+		sourcePortRange: r.SourcePortRange,
+		processName:     r.ProcessName,
+		processPath:     r.ProcessPath,
+		packageName:     r.PackageName,
+		ruleSet:         r.RuleSet,
+		geo:             geoItems(r.Geosite, r.GeoIP, r.SourceGeoIP),
+		invert:          r.Invert,
+	}
+}
+
+func defaultDNSRuleItems(r option.DefaultDNSRule) ruleItems {
+	return ruleItems{
+		inbound:         r.Inbound,
+		network:         r.Network,
+		protocol:        r.Protocol,
+		domain:          r.Domain,
+		domainSuffix:    r.DomainSuffix,
+		domainKeyword:   r.DomainKeyword,
+		domainRegex:     r.DomainRegex,
+		sourceIPCIDR:    r.SourceIPCIDR,
+		port:            r.Port,
+		portRange:       r.PortRange,
+		sourcePort:      r.SourcePort,
+		sourcePortRange: r.SourcePortRange,
+		processName:     r.ProcessName,
+		processPath:     r.ProcessPath,
+		packageName:     r.PackageName,
+		ruleSet:         r.RuleSet,
+		outbound:        r.Outbound,
+		geo:             geoItems(r.Geosite, nil, r.SourceGeoIP),
+		invert:          r.Invert,
+	}
+}
+
+func headlessRuleItems(r option.DefaultHeadlessRule) ruleItems {
+	items := ruleItems{
+		network:         r.Network,
+		domain:          r.Domain,
+		domainSuffix:    r.DomainSuffix,
+		domainKeyword:   r.DomainKeyword,
+		domainRegex:     r.DomainRegex,
+		domainMatcher:   r.DomainMatcher,
+		ipCIDR:          r.IPCIDR,
+		sourceIPCIDR:    r.SourceIPCIDR,
+		port:            r.Port,
+		portRange:       r.PortRange,
+		sourcePort:      r.SourcePort,
+		sourcePortRange: r.SourcePortRange,
+		processName:     r.ProcessName,
+		processPath:     r.ProcessPath,
+		packageName:     r.PackageName,
+		invert:          r.Invert,
+	}
+	// binary rule-sets are read into sets instead of lists
+	if r.IPSet != nil {
+		items.ipSet = r.IPSet.Contains
+	}
+	if r.SourceIPSet != nil {
+		items.sourceIPSet = r.SourceIPSet.Contains
+	}
+	return items
+}
+
+func geoItems(geosite, geoip, sourceGeoip []string) []string {
+	var items []string
+	for _, code := range geosite {
+		items = append(items, "geosite:"+code)
+	}
+	for _, code := range geoip {
+		items = append(items, "geoip:"+code)
+	}
+	for _, code := range sourceGeoip {
+		items = append(items, "source geoip:"+code)
+	}
+	return items
+}
+
+func matchAddr(addr netip.Addr, cidrs []string, set func(netip.Addr) bool) bool {
+	if set != nil && set(addr) {
+		return true
+	}
+	for _, cidr := range cidrs {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil {
+			if prefix.Contains(addr) {
+				return true
+			}
+		} else if ip, err := netip.ParseAddr(cidr); err == nil && ip.Unmap() == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPort(port uint16, ports []uint16, ranges []string) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	for _, portRange := range ranges {
+		from, to, found := strings.Cut(portRange, ":")
+		if !found {
+			continue
+		}
+		start, end := uint64(0), uint64(65535)
+		var err error
+		if from != "" {
+			if start, err = strconv.ParseUint(from, 10, 16); err != nil {
+				continue
+			}
+		}
+		if to != "" {
+			if end, err = strconv.ParseUint(to, 10, 16); err != nil {
+				continue
+			}
+		}
+		if uint64(port) >= start && uint64(port) <= end {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrivateAddr(addr netip.Addr) bool {
+	return addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsUnspecified()
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func nonEmpty(list []string) []string {
+	var items []string
+	for _, item := range list {
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sagernet/sing-box/option"
+)
+
+func TestExplainRoute(t *testing.T) {
+	list := filepath.Join(t.TempDir(), "trackers.json")
+	err := os.WriteFile(list, []byte(`{"version":1,"rules":[{"domain_suffix":["tracker.net"]}]}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := DefaultHiddifyOptions()
+	opt.RuleSetDir = t.TempDir()
+	opt.EnableDNSRouting = true
+	opt.BypassLAN = true
+	opt.BlockAds = true
+	opt.Region = "ir"
+	opt.Rules = []Rule{
+		{Domains: "domain:example.com", Outbound: "block"},
+		{RuleSetUrl: list, Outbound: "bypass"},
+		{Mode: RuleModeAnd, Rules: []Rule{{Port: "8000:9000"}, {Network: "udp"}}, Outbound: "proxy"},
+	}
+	explain := func(request RouteRequest) *RouteExplanation {
+		t.Helper()
+		metadata, err := newRouteMetadata(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		options := option.Options{DNS: &option.DNSOptions{Final: DNSRemoteTag}}
+		b := newBuild(BuildEnv{})
+		b.origins = &ruleOrigins{}
+		setRoutingOptions(&options, opt, b)
+		return explainRoute(opt, &options, b.origins, metadata)
+	}
+
+	result := explain(RouteRequest{Domain: "www.example.com", Port: 443})
+	if result.RouteRule == nil || result.RouteRule.Origin.Kind != RuleOriginRule || result.RouteRule.Origin.Index != 0 {
+		t.Fatalf("unexpected route rule: %+v", result.RouteRule)
+	}
+	if result.Outbound != OutboundBlockTag || result.DNSServer != DNSBlockTag {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	result = explain(RouteRequest{Domain: "cdn.tracker.net", Port: 443})
+	if result.RouteRule == nil || result.RouteRule.Origin.Index != 1 || result.Outbound != OutboundBypassTag {
+		t.Errorf("rule-set rule did not match: %+v", result.RouteRule)
+	}
+	if result.DNSServer != DNSDirectTag {
+		t.Errorf("unexpected dns server: %s", result.DNSServer)
+	}
+
+	result = explain(RouteRequest{IP: "1.1.1.1", Port: 8443, Network: "udp"})
+	if result.RouteRule == nil || result.RouteRule.Origin.Index != 2 || result.DNSServer != "" {
+		t.Errorf("logical rule did not match: %+v", result)
+	}
+
+	result = explain(RouteRequest{Domain: "bank.ir", Port: 443})
+	if result.RouteRule == nil || result.RouteRule.Origin.Kind != RuleOriginRegion || result.Outbound != OutboundDirectTag {
+		t.Errorf("region rule did not match: %+v", result.RouteRule)
+	}
+
+	result = explain(RouteRequest{IP: "192.168.1.1", Port: 80})
+	if result.RouteRule == nil || result.RouteRule.Origin.Kind != RuleOriginBypassLAN {
+		t.Errorf("bypass lan rule did not match: %+v", result.RouteRule)
+	}
+
+	result = explain(RouteRequest{Domain: "github.com", Port: 443})
+	if result.RouteRule != nil || result.Outbound != OutboundSelectTag || result.DNSServer != DNSRemoteTag {
+		t.Errorf("expected the final outbound: %+v", result)
+	}
+	var uncached bool
+	for _, warning := range result.Warnings {
+		uncached = uncached || strings.Contains(warning, "geosite-ads is not cached")
+	}
+	if !uncached {
+		t.Errorf("missing warning for the ads rule-set: %v", result.Warnings)
+	}
+
+	if _, err := newRouteMetadata(RouteRequest{Port: 443}); err == nil {
+		t.Error("expected error without domain and ip")
+	}
+}
+
+func TestExplainRouteEqualRules(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	opt.EnableDNSRouting = true
+	opt.RemoteDnsAddress = "1.1.1.1"
+	// the same rule as the one of the direct dns domains
+	opt.Rules = []Rule{{Domains: "example.org", Outbound: OutboundBypassTag}}
+	metadata, err := newRouteMetadata(RouteRequest{Domain: "example.org", Port: 443})
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := option.Options{DNS: &option.DNSOptions{Final: DNSRemoteTag}}
+	b := newBuild(BuildEnv{})
+	b.origins = &ruleOrigins{}
+	setRoutingOptions(&options, opt, b)
+	addForceDirect(&options, opt, b, map[string]bool{"example.org": true})
+
+	result := explainRoute(opt, &options, b.origins, metadata)
+	if result.DNSRule == nil || result.DNSRule.Index != 0 || result.DNSRule.Origin.Kind != RuleOriginBuiltIn {
+		t.Errorf("the prepended dns rule should be built-in: %+v", result.DNSRule)
+	}
+	if result.RouteRule == nil || result.RouteRule.Origin.Kind != RuleOriginRule {
+		t.Errorf("unexpected route rule: %+v", result.RouteRule)
+	}
+	if origin := b.origins.dnsOrigin(1); origin.Kind != RuleOriginRule {
+		t.Errorf("the user dns rule should move behind the prepended one: %+v", origin)
+	}
+}
+
+func TestOutboundChain(t *testing.T) {
+	outbounds := []option.Outbound{
+		{Type: "selector", Tag: OutboundSelectTag, SelectorOptions: option.SelectorOutboundOptions{Outbounds: []string{OutboundURLTestTag, "a"}}},
+		{Type: "urltest", Tag: OutboundURLTestTag},
+	}
+	chain, outboundType := outboundChain(outbounds, OutboundSelectTag)
+	if len(chain) != 2 || chain[1] != OutboundURLTestTag || outboundType != "urltest" {
+		t.Errorf("unexpected chain: %v %s", chain, outboundType)
+	}
+}
+
+func TestRuleOriginTrafficKey(t *testing.T) {
+	tests := []struct {
+		origin RuleOrigin
+		index  int
+		want   string
+	}{
+		{RuleOrigin{Kind: RuleOriginRule, Index: 2}, 7, "rule-2"},
+		{RuleOrigin{Kind: RuleOriginBlockAds}, 3, RuleOriginBlockAds},
+		{RuleOrigin{Kind: RuleOriginBuiltIn}, 0, "route-0"},
+		{RuleOrigin{}, 4, "route-4"},
+	}
+	for _, test := range tests {
+		if key := test.origin.TrafficKey(test.index); key != test.want {
+			t.Errorf("%+v at %d: got %s, want %s", test.origin, test.index, key, test.want)
+		}
+	}
+}
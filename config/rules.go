@@ -1,20 +1,181 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
 	"strconv"
 	"strings"
 
+	C "github.com/sagernet/sing-box/constant"
 	"github.com/sagernet/sing-box/option"
 )
 
+const (
+	RuleModeAnd = "and"
+	RuleModeOr  = "or"
+)
+
+// Rule is a user routing rule. List fields are comma separated. When Mode is
+// set the rule is a logical rule made of Rules and its own match fields are
+// ignored; sub rules only contribute their match fields and invert.
+// Outbound is bypass, block, proxy or any outbound tag.
 type Rule struct {
-	RuleSetUrl string `json:"rule-set-url"`
-	Domains    string `json:"domains"`
-	IP         string `json:"ip"`
-	Port       string `json:"port"`
-	Network    string `json:"network"`
-	Protocol   string `json:"protocol"`
-	Outbound   string `json:"outbound"`
+	RuleSetUrl  string `json:"rule-set-url"`
+	Domains     string `json:"domains"`
+	IP          string `json:"ip"`
+	SourceIP    string `json:"source-ip"`
+	Port        string `json:"port"`
+	Network     string `json:"network"`
+	Protocol    string `json:"protocol"`
+	ProcessName string `json:"process-name"`
+	ProcessPath string `json:"process-path"`
+	PackageName string `json:"package-name"`
+	Invert      bool   `json:"invert"`
+	Mode        string `json:"mode"`
+	Rules       []Rule `json:"rules"`
+	Outbound    string `json:"outbound"`
+}
+
+// ruleSetURLTag returns a stable rule-set tag for a rule-set url. Local
+// rule-sets are tagged by their absolute path, so a file:// link and the
+// path it points to share a tag.
+func ruleSetURLTag(link string) string {
+	sum := sha256.Sum256([]byte(ruleSetLocation(link)))
+	return "rule-set-" + hex.EncodeToString(sum[:4])
+}
+
+// ruleSetLocation returns the http(s) url of a remote rule-set or the
+// absolute path of a local one.
+func ruleSetLocation(link string) string {
+	if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+		return link
+	}
+	path := strings.TrimPrefix(link, "file://")
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// RuleSetSources returns the rule-sets referenced by the rule and its sub
+// rules.
+func (r *Rule) RuleSetSources() []RuleSetSource {
+	var sources []RuleSetSource
+	for _, link := range splitRuleList(r.RuleSetUrl) {
+		source := RuleSetSource{
+			Tag:    ruleSetURLTag(link),
+			URL:    ruleSetLocation(link),
+			Format: C.RuleSetFormatBinary,
+		}
+		if strings.HasSuffix(strings.ToLower(link), ".json") {
+			source.Format = C.RuleSetFormatSource
+		}
+		sources = append(sources, source)
+	}
+	for i := range r.Rules {
+		sources = append(sources, r.Rules[i].RuleSetSources()...)
+	}
+	return sources
+}
+
+// MakeRouteRule returns the route rule for outbound, or false if the rule
+// has no condition.
+func (r *Rule) MakeRouteRule(outbound string) (option.Rule, bool) {
+	if r.Mode == "" {
+		rule := r.MakeRule()
+		rule.Outbound = outbound
+		return option.Rule{Type: C.RuleTypeDefault, DefaultOptions: rule}, rule.IsValid()
+	}
+	logical := option.LogicalRule{
+		Mode:     r.Mode,
+		Invert:   r.Invert,
+		Outbound: outbound,
+	}
+	for i := range r.Rules {
+		rule := r.Rules[i].MakeRule()
+		if rule.IsValid() {
+			logical.Rules = append(logical.Rules, rule)
+		}
+	}
+	return option.Rule{Type: C.RuleTypeLogical, LogicalOptions: logical}, r.validMode() && len(logical.Rules) > 0
+}
+
+// MakeDNSRouteRule returns the dns rule for server, or false if the rule can
+// not be expressed as a dns rule. Conditions a dns query does not have (ip,
+// port, network and protocol) are left out, so an inverted rule or a logical
+// rule using them would match different traffic and is skipped.
+func (r *Rule) MakeDNSRouteRule(server string) (option.DNSRule, bool) {
+	if r.Mode == "" {
+		if r.Invert && r.hasRouteOnlyConditions() {
+			return option.DNSRule{}, false
+		}
+		rule := r.MakeDNSRule()
+		rule.Server = server
+		return option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: rule}, rule.IsValid()
+	}
+	logical := option.LogicalDNSRule{
+		Mode:   r.Mode,
+		Invert: r.Invert,
+		Server: server,
+	}
+	for i := range r.Rules {
+		if r.Rules[i].hasRouteOnlyConditions() {
+			return option.DNSRule{}, false
+		}
+		rule := r.Rules[i].MakeDNSRule()
+		if rule.IsValid() {
+			logical.Rules = append(logical.Rules, rule)
+		}
+	}
+	return option.DNSRule{Type: C.RuleTypeLogical, LogicalOptions: logical}, r.validMode() && len(logical.Rules) > 0
+}
+
+// MakeFakeDNSRule returns the dns rule for server limited to inbounds. Rules
+// using invert are skipped as the inbound condition would be inverted too.
+func (r *Rule) MakeFakeDNSRule(server string, inbounds []string) (option.DNSRule, bool) {
+	if r.hasInvert() {
+		return option.DNSRule{}, false
+	}
+	rule, ok := r.MakeDNSRouteRule(server)
+	if !ok {
+		return rule, false
+	}
+	rule.DefaultOptions.Inbound = inbounds
+	for i := range rule.LogicalOptions.Rules {
+		rule.LogicalOptions.Rules[i].Inbound = inbounds
+	}
+	return rule, true
+}
+
+func (r *Rule) hasInvert() bool {
+	if r.Invert {
+		return true
+	}
+	for i := range r.Rules {
+		if r.Rules[i].hasInvert() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) validMode() bool {
+	return r.Mode == RuleModeAnd || r.Mode == RuleModeOr
+}
+
+func (r *Rule) hasRouteOnlyConditions() bool {
+	return r.IP != "" || r.Port != "" || r.Network != "" || r.Protocol != ""
+}
+
+func splitRuleList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 func (r *Rule) MakeRule() option.DefaultRule {
@@ -34,6 +195,14 @@ func (r *Rule) MakeRule() option.DefaultRule {
 	if len(r.Protocol) > 0 {
 		rule.Protocol = append(rule.Protocol, strings.Split(r.Protocol, ",")...)
 	}
+	rule.SourceIPCIDR = append(rule.SourceIPCIDR, splitRuleList(r.SourceIP)...)
+	rule.ProcessName = append(rule.ProcessName, splitRuleList(r.ProcessName)...)
+	rule.ProcessPath = append(rule.ProcessPath, splitRuleList(r.ProcessPath)...)
+	rule.PackageName = append(rule.PackageName, splitRuleList(r.PackageName)...)
+	for _, link := range splitRuleList(r.RuleSetUrl) {
+		rule.RuleSet = append(rule.RuleSet, ruleSetURLTag(link))
+	}
+	rule.Invert = r.Invert
 	return rule
 }
 
@@ -53,6 +222,14 @@ func (r *Rule) MakeDNSRule() option.DefaultDNSRule {
 			rule.DomainKeyword = append(rule.DomainKeyword, strings.ToLower(strings.TrimPrefix(item, "keyword:")))
 		}
 	}
+	rule.SourceIPCIDR = append(rule.SourceIPCIDR, splitRuleList(r.SourceIP)...)
+	rule.ProcessName = append(rule.ProcessName, splitRuleList(r.ProcessName)...)
+	rule.ProcessPath = append(rule.ProcessPath, splitRuleList(r.ProcessPath)...)
+	rule.PackageName = append(rule.PackageName, splitRuleList(r.PackageName)...)
+	for _, link := range splitRuleList(r.RuleSetUrl) {
+		rule.RuleSet = append(rule.RuleSet, ruleSetURLTag(link))
+	}
+	rule.Invert = r.Invert
 	return rule
 }
 
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+)
+
+func TestRuleMakeRouteRule(t *testing.T) {
+	rule := Rule{
+		Domains:     "domain:example.com",
+		SourceIP:    "192.168.1.0/24",
+		ProcessName: "firefox, chrome",
+		PackageName: "org.telegram.messenger",
+		RuleSetUrl:  "https://example.com/ads.srs",
+		Invert:      true,
+	}
+	route, ok := rule.MakeRouteRule("my-out")
+	if !ok || route.Type != C.RuleTypeDefault {
+		t.Fatalf("unexpected route rule: %+v", route)
+	}
+	options := route.DefaultOptions
+	if options.Outbound != "my-out" || !options.Invert {
+		t.Errorf("unexpected outbound or invert: %+v", options)
+	}
+	if len(options.ProcessName) != 2 || options.ProcessName[1] != "chrome" {
+		t.Errorf("unexpected process names: %v", options.ProcessName)
+	}
+	if len(options.SourceIPCIDR) != 1 || len(options.PackageName) != 1 {
+		t.Errorf("unexpected source or package: %+v", options)
+	}
+	if len(options.RuleSet) != 1 || options.RuleSet[0] != ruleSetURLTag(rule.RuleSetUrl) {
+		t.Errorf("unexpected rule set: %v", options.RuleSet)
+	}
+
+	sources := rule.RuleSetSources()
+	if len(sources) != 1 || !sources[0].IsRemote() || sources[0].Format != C.RuleSetFormatBinary {
+		t.Errorf("unexpected rule sets: %+v", sources)
+	}
+}
+
+func TestRuleLogical(t *testing.T) {
+	rule := Rule{
+		Mode: RuleModeAnd,
+		Rules: []Rule{
+			{Domains: "full:example.com"},
+			{ProcessPath: "/usr/bin/curl", Invert: true},
+			{},
+		},
+	}
+	route, ok := rule.MakeRouteRule(OutboundBlockTag)
+	if !ok || route.Type != C.RuleTypeLogical {
+		t.Fatalf("unexpected route rule: %+v", route)
+	}
+	if route.LogicalOptions.Mode != RuleModeAnd || len(route.LogicalOptions.Rules) != 2 || !route.LogicalOptions.Rules[1].Invert {
+		t.Errorf("unexpected logical rule: %+v", route.LogicalOptions)
+	}
+
+	dns, ok := rule.MakeDNSRouteRule(DNSBlockTag)
+	if !ok || dns.Type != C.RuleTypeLogical || dns.LogicalOptions.Server != DNSBlockTag || len(dns.LogicalOptions.Rules) != 2 {
+		t.Errorf("unexpected dns rule: %+v", dns)
+	}
+	if _, ok := rule.MakeFakeDNSRule(DNSFakeTag, []string{InboundTUNTag}); ok {
+		t.Error("fake dns rule should be skipped for inverted sub rules")
+	}
+
+	rule.Mode = "xor"
+	if _, ok := rule.MakeRouteRule(OutboundBlockTag); ok {
+		t.Error("unknown mode should be rejected")
+	}
+}
+
+func TestRuleDNSSkipsRouteOnlyConditions(t *testing.T) {
+	rule := Rule{Domains: "domain:example.com", Port: "443", Invert: true}
+	if _, ok := rule.MakeDNSRouteRule(DNSRemoteTag); ok {
+		t.Error("inverted rule with a port condition should not make a dns rule")
+	}
+	rule.Invert = false
+	dns, ok := rule.MakeDNSRouteRule(DNSRemoteTag)
+	if !ok || len(dns.DefaultOptions.DomainSuffix) != 1 || dns.DefaultOptions.Server != DNSRemoteTag {
+		t.Errorf("unexpected dns rule: %+v", dns)
+	}
+	fake, ok := rule.MakeFakeDNSRule(DNSFakeTag, []string{InboundTUNTag})
+	if !ok || len(fake.DefaultOptions.Inbound) != 1 {
+		t.Errorf("unexpected fake dns rule: %+v", fake)
+	}
+}
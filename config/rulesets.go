@@ -0,0 +1,143 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+const (
+	DefaultRuleSetMirror = "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set"
+	// ruleSetServerInterval is how often sing-box asks the core's rule-set
+	// server for changes; it is a loopback request.
+	ruleSetServerInterval = time.Hour
+)
+
+type RuleSetOptions struct {
+	// RuleSetMirror replaces DefaultRuleSetMirror as the base url of the
+	// ads and region rule-sets.
+	RuleSetMirror string `json:"rule-set-mirror"`
+	// RuleSetDir holds bundled, local and downloaded rule-set files. A
+	// rule-set found there is never downloaded by sing-box.
+	RuleSetDir            string            `json:"rule-set-dir"`
+	RuleSetUpdateInterval DurationInSeconds `json:"rule-set-update-interval"`
+	// RuleSetServer is the base url the core serves RuleSetDir from, so that
+	// rule-sets it updates reach sing-box without a restart. It is set at
+	// runtime and never stored.
+	RuleSetServer string `json:"-"`
+}
+
+// RuleSetSource is a rule-set used by the config and where it comes from;
+// URL is either a http(s) url or a local path.
+type RuleSetSource struct {
+	Tag    string
+	URL    string
+	Format string
+}
+
+func (s RuleSetSource) IsRemote() bool {
+	return strings.HasPrefix(s.URL, "http://") || strings.HasPrefix(s.URL, "https://")
+}
+
+// FileName is the name of the rule-set in RuleSetDir.
+func (s RuleSetSource) FileName() string {
+	if s.Format == C.RuleSetFormatSource {
+		return s.Tag + ".json"
+	}
+	return s.Tag + ".srs"
+}
+
+var blockRuleSets = map[string]string{
+	"geosite-ads":          "block/geosite-category-ads-all.srs",
+	"geosite-malware":      "block/geosite-malware.srs",
+	"geosite-phishing":     "block/geosite-phishing.srs",
+	"geosite-cryptominers": "block/geosite-cryptominers.srs",
+	"geoip-phishing":       "block/geoip-phishing.srs",
+	"geoip-malware":        "block/geoip-malware.srs",
+}
+
+var blockRuleSetTags = []string{
+	"geosite-ads",
+	"geosite-malware",
+	"geosite-phishing",
+	"geosite-cryptominers",
+	"geoip-malware",
+	"geoip-phishing",
+}
+
+func ruleSetMirrorURL(opt *HiddifyOptions, path string) string {
+	mirror := opt.RuleSetMirror
+	if mirror == "" {
+		mirror = DefaultRuleSetMirror
+	}
+	return strings.TrimSuffix(mirror, "/") + "/" + path
+}
+
+// RuleSetSources lists the rule-sets the config built from opt uses.
+func RuleSetSources(opt *HiddifyOptions) []RuleSetSource {
+	var sources []RuleSetSource
+	added := map[string]bool{}
+	add := func(source RuleSetSource) {
+		if !added[source.Tag] {
+			added[source.Tag] = true
+			sources = append(sources, source)
+		}
+	}
+	if opt.BlockAds {
+		for _, tag := range blockRuleSetTags {
+			add(RuleSetSource{Tag: tag, URL: ruleSetMirrorURL(opt, blockRuleSets[tag]), Format: C.RuleSetFormatBinary})
+		}
+	}
+	if opt.Region != "other" {
+		for _, tag := range []string{"geoip-" + opt.Region, "geosite-" + opt.Region} {
+			add(RuleSetSource{Tag: tag, URL: ruleSetMirrorURL(opt, "country/"+tag+".srs"), Format: C.RuleSetFormatBinary})
+		}
+	}
+	for i := range opt.Rules {
+		for _, source := range opt.Rules[i].RuleSetSources() {
+			add(source)
+		}
+	}
+	return sources
+}
+
+// makeRuleSet returns the rule-set option for source, preferring a file in
+// RuleSetDir (served by the core when it runs a rule-set server) over
+// downloading it.
+func makeRuleSet(opt *HiddifyOptions, files Files, source RuleSetSource) option.RuleSet {
+	ruleset := option.RuleSet{
+		Tag:    source.Tag,
+		Format: source.Format,
+	}
+	if !source.IsRemote() {
+		ruleset.Type = C.RuleSetTypeLocal
+		ruleset.LocalOptions = option.LocalRuleSet{Path: source.URL}
+		return ruleset
+	}
+	var cached bool
+	if opt.RuleSetDir != "" {
+		cached = files.Exists(filepath.Join(opt.RuleSetDir, source.FileName()))
+	}
+	switch {
+	case cached && opt.RuleSetServer != "":
+		ruleset.Type = C.RuleSetTypeRemote
+		ruleset.RemoteOptions = option.RemoteRuleSet{
+			URL:            strings.TrimSuffix(opt.RuleSetServer, "/") + "/" + source.FileName(),
+			DownloadDetour: OutboundDirectTag,
+			UpdateInterval: option.Duration(ruleSetServerInterval),
+		}
+	case cached:
+		ruleset.Type = C.RuleSetTypeLocal
+		ruleset.LocalOptions = option.LocalRuleSet{Path: filepath.Join(opt.RuleSetDir, source.FileName())}
+	default:
+		ruleset.Type = C.RuleSetTypeRemote
+		ruleset.RemoteOptions = option.RemoteRuleSet{
+			URL:            source.URL,
+			UpdateInterval: option.Duration(opt.RuleSetUpdateInterval.Duration()),
+		}
+	}
+	return ruleset
+}
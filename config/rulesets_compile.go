@@ -0,0 +1,342 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sagernet/sing-box/common/srs"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+type RuleSetListFormat string
+
+const (
+	// RuleSetListAuto detects the format of every line on its own, so lists
+	// mixing domains, hosts entries and CIDRs can be compiled at once.
+	RuleSetListAuto    RuleSetListFormat = "auto"
+	RuleSetListDomains RuleSetListFormat = "domains"
+	RuleSetListHosts   RuleSetListFormat = "hosts"
+	RuleSetListAdblock RuleSetListFormat = "adblock"
+	RuleSetListCIDR    RuleSetListFormat = "cidr"
+)
+
+// localRuleSetDir is where compiled rule-sets are kept inside RuleSetDir.
+const localRuleSetDir = "local"
+
+var (
+	ruleSetNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	hostnameRegex    = regexp.MustCompile(`^[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?(\.[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?)*$`)
+	// adblock modifiers that do not narrow a rule down for a dns or
+	// connection level block; third-party and popup rules only block some
+	// requests to the host and are reported like other modifiers
+	adblockModifiers = map[string]bool{"important": true, "all": true, "document": true}
+)
+
+// RuleSetList is the headless rule compiled from lists and what could not be
+// used from them.
+type RuleSetList struct {
+	Rule    option.DefaultHeadlessRule
+	Lines   int
+	Skipped []string
+}
+
+// IsEmpty reports whether the list holds no rule at all.
+func (l *RuleSetList) IsEmpty() bool {
+	rule := l.Rule
+	return len(rule.Domain)+len(rule.DomainSuffix)+len(rule.DomainKeyword)+len(rule.DomainRegex)+len(rule.IPCIDR) == 0
+}
+
+// Parse adds the entries of a domain list, hosts file, adblock filter or
+// CIDR list to the list. Comments, cosmetic filters and entries that can not
+// be matched by domain or address are skipped.
+func (l *RuleSetList) Parse(content string, format RuleSetListFormat) error {
+	switch format {
+	case "", RuleSetListAuto, RuleSetListDomains, RuleSetListHosts, RuleSetListAdblock, RuleSetListCIDR:
+	default:
+		return fmt.Errorf("unknown list format %s", format)
+	}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+		l.Lines++
+		lineFormat := format
+		if lineFormat == "" || lineFormat == RuleSetListAuto {
+			lineFormat = detectRuleSetLine(line)
+		}
+		var ok bool
+		switch lineFormat {
+		case RuleSetListHosts:
+			ok = l.addHostsLine(line)
+		case RuleSetListAdblock:
+			ok = l.addAdblockLine(line)
+		case RuleSetListCIDR:
+			ok = l.addCIDR(line)
+		default:
+			ok = l.addDomain(line)
+		}
+		if !ok {
+			l.Skipped = append(l.Skipped, line)
+		}
+	}
+	return scanner.Err()
+}
+
+func detectRuleSetLine(line string) RuleSetListFormat {
+	if strings.HasPrefix(line, "||") || strings.HasPrefix(line, "|") || strings.HasPrefix(line, "@@") ||
+		strings.HasPrefix(line, "!") || strings.HasPrefix(line, "[") || strings.Contains(line, "##") || strings.Contains(line, "^") {
+		return RuleSetListAdblock
+	}
+	fields := strings.Fields(line)
+	if _, err := netip.ParseAddr(fields[0]); err == nil {
+		if len(fields) > 1 {
+			return RuleSetListHosts
+		}
+		return RuleSetListCIDR
+	}
+	if _, err := netip.ParsePrefix(fields[0]); err == nil {
+		return RuleSetListCIDR
+	}
+	return RuleSetListDomains
+}
+
+// addDomain adds a domain list entry. Plain entries match the domain and its
+// subdomains; the full:, domain:, keyword: and regexp: prefixes of Rule.Domains
+// are supported as well.
+func (l *RuleSetList) addDomain(line string) bool {
+	line = strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
+	prefix, value, found := strings.Cut(line, ":")
+	if !found {
+		prefix, value = "domain", line
+	}
+	value = strings.TrimSpace(value)
+	// regexp values are case sensitive, so only the others are lowered
+	switch prefix {
+	case "full", "domain", "keyword":
+		value = strings.ToLower(value)
+	}
+	switch prefix {
+	case "full":
+		if !validHostname(value) {
+			return false
+		}
+		l.Rule.Domain = append(l.Rule.Domain, value)
+	case "domain":
+		value = strings.TrimPrefix(strings.TrimPrefix(value, "+."), "*.")
+		value = strings.TrimPrefix(value, ".")
+		if !validHostname(value) {
+			return false
+		}
+		l.Rule.DomainSuffix = append(l.Rule.DomainSuffix, value)
+	case "keyword":
+		if value == "" {
+			return false
+		}
+		l.Rule.DomainKeyword = append(l.Rule.DomainKeyword, value)
+	case "regexp":
+		if _, err := regexp.Compile(value); err != nil || value == "" {
+			return false
+		}
+		l.Rule.DomainRegex = append(l.Rule.DomainRegex, value)
+	default:
+		return false
+	}
+	return true
+}
+
+// addHostsLine adds the names of a hosts file entry as full domains.
+func (l *RuleSetList) addHostsLine(line string) bool {
+	fields := strings.Fields(strings.SplitN(line, "#", 2)[0])
+	if len(fields) < 2 {
+		return false
+	}
+	if _, err := netip.ParseAddr(fields[0]); err != nil {
+		return false
+	}
+	var added bool
+	for _, name := range fields[1:] {
+		name = strings.ToLower(name)
+		switch name {
+		case "localhost", "localhost.localdomain", "local", "broadcasthost", "ip6-localhost", "ip6-loopback", "0.0.0.0":
+			continue
+		}
+		if !validHostname(name) {
+			continue
+		}
+		l.Rule.Domain = append(l.Rule.Domain, name)
+		added = true
+	}
+	return added
+}
+
+// addAdblockLine adds a blocking rule of an adblock filter list. Only rules
+// matching whole hosts are used; exceptions, cosmetic filters and rules
+// matching paths or limited by modifiers can not be expressed by a rule-set.
+func (l *RuleSetList) addAdblockLine(line string) bool {
+	if strings.HasPrefix(line, "!") || strings.HasPrefix(line, "[") {
+		// comments and the list header
+		return true
+	}
+	if strings.HasPrefix(line, "@@") || strings.Contains(line, "#") {
+		return false
+	}
+	rule, modifiers, _ := strings.Cut(line, "$")
+	if modifiers != "" {
+		for _, modifier := range strings.Split(modifiers, ",") {
+			if !adblockModifiers[strings.TrimSpace(modifier)] {
+				return false
+			}
+		}
+	}
+	rule = strings.TrimSuffix(rule, "|")
+	rule = strings.TrimSuffix(rule, "^")
+	switch {
+	case strings.HasPrefix(rule, "||"):
+		return l.addDomain("domain:" + strings.TrimPrefix(rule, "||"))
+	case strings.HasPrefix(rule, "|"):
+		host := strings.TrimPrefix(rule, "|")
+		for _, scheme := range []string{"https://", "http://"} {
+			host = strings.TrimPrefix(host, scheme)
+		}
+		host = strings.TrimSuffix(host, "/")
+		return l.addDomain("full:" + host)
+	case strings.HasPrefix(rule, "/") && strings.HasSuffix(rule, "/") && len(rule) > 2:
+		return l.addDomain("regexp:" + rule[1:len(rule)-1])
+	default:
+		if validHostname(strings.ToLower(rule)) && strings.Contains(rule, ".") {
+			return l.addDomain("domain:" + rule)
+		}
+		return false
+	}
+}
+
+// addCIDR adds an address or prefix; a plain address becomes a single host
+// prefix.
+func (l *RuleSetList) addCIDR(line string) bool {
+	value := strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		l.Rule.IPCIDR = append(l.Rule.IPCIDR, prefix.Masked().String())
+		return true
+	}
+	if addr, err := netip.ParseAddr(value); err == nil {
+		l.Rule.IPCIDR = append(l.Rule.IPCIDR, netip.PrefixFrom(addr, addr.BitLen()).String())
+		return true
+	}
+	return false
+}
+
+func validHostname(name string) bool {
+	return len(name) <= 253 && hostnameRegex.MatchString(name)
+}
+
+// headlessRule returns the rule with every condition sorted and without
+// duplicates, so compiling the same lists gives the same rule-set.
+func (l *RuleSetList) headlessRule() option.HeadlessRule {
+	rule := l.Rule
+	rule.Domain = sortedUnique(rule.Domain)
+	rule.DomainSuffix = sortedUnique(rule.DomainSuffix)
+	rule.DomainKeyword = sortedUnique(rule.DomainKeyword)
+	rule.DomainRegex = sortedUnique(rule.DomainRegex)
+	rule.IPCIDR = sortedUnique(rule.IPCIDR)
+	return option.HeadlessRule{Type: C.RuleTypeDefault, DefaultOptions: rule}
+}
+
+func sortedUnique(items []string) []string {
+	if len(items) == 0 {
+		return nil
+	}
+	sort.Strings(items)
+	unique := items[:1]
+	for _, item := range items[1:] {
+		if item != unique[len(unique)-1] {
+			unique = append(unique, item)
+		}
+	}
+	return unique
+}
+
+// Compile encodes the list as a rule-set of format, either
+// C.RuleSetFormatSource (json) or C.RuleSetFormatBinary (srs).
+func (l *RuleSetList) Compile(format string) ([]byte, error) {
+	if l.IsEmpty() {
+		return nil, fmt.Errorf("no rule in the lists")
+	}
+	ruleSet := option.PlainRuleSet{Rules: []option.HeadlessRule{l.headlessRule()}}
+	switch format {
+	case C.RuleSetFormatSource:
+		content, err := json.MarshalIndent(option.PlainRuleSetCompat{Version: C.RuleSetVersion1, Options: ruleSet}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(content, '\n'), nil
+	case C.RuleSetFormatBinary, "":
+		var buffer bytes.Buffer
+		if err := srs.Write(&buffer, ruleSet); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown rule-set format %s", format)
+	}
+}
+
+// SaveLocalRuleSet stores a compiled rule-set as name in the local dir of
+// RuleSetDir and returns its path, which can be used as a Rule.RuleSetUrl.
+func SaveLocalRuleSet(opt *HiddifyOptions, name string, format string, content []byte) (string, error) {
+	if !ruleSetNameRegex.MatchString(name) {
+		return "", fmt.Errorf("invalid rule-set name %q", name)
+	}
+	extension := ".srs"
+	if format == C.RuleSetFormatSource {
+		extension = ".json"
+	}
+	dir := filepath.Join(opt.RuleSetDir, localRuleSetDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path, err := filepath.Abs(filepath.Join(dir, strings.TrimSuffix(name, extension)+extension))
+	if err != nil {
+		return "", err
+	}
+	return path, os.WriteFile(path, content, 0o644)
+}
+
+// LocalRuleSets returns the rule-sets saved by SaveLocalRuleSet, tagged as
+// they are when used in a Rule.RuleSetUrl.
+func LocalRuleSets(opt *HiddifyOptions) ([]RuleSetSource, error) {
+	dir, err := filepath.Abs(filepath.Join(opt.RuleSetDir, localRuleSetDir))
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var sources []RuleSetSource
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		switch {
+		case entry.IsDir():
+		case filepath.Ext(path) == ".srs":
+			sources = append(sources, RuleSetSource{Tag: ruleSetURLTag(path), URL: path, Format: C.RuleSetFormatBinary})
+		case filepath.Ext(path) == ".json":
+			sources = append(sources, RuleSetSource{Tag: ruleSetURLTag(path), URL: path, Format: C.RuleSetFormatSource})
+		}
+	}
+	return sources, nil
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+)
+
+func TestRuleSetListParse(t *testing.T) {
+	var list RuleSetList
+	err := list.Parse(`# block list
+example.com
++.ads.example.net
+full:tracker.example.org
+keyword:doubleclick
+0.0.0.0 ads.example.io ads2.example.io # hosts entry
+127.0.0.1 localhost
+10.0.0.0/8
+192.168.1.10
+2001:db8::1/64
+! adblock comment
+||adserver.example.com^
+||cdn.example.com^$important
+||video.example.com^$domain=example.org
+||tracker.example.com^$third-party
+||popups.example.com^$popup
+@@||allowed.example.com^
+example.com##.banner
+||example.com/ads/*
+not a domain
+`, RuleSetListAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule := list.headlessRule().DefaultOptions
+	wantSuffix := []string{"ads.example.net", "adserver.example.com", "cdn.example.com", "example.com"}
+	if strings.Join(rule.DomainSuffix, ",") != strings.Join(wantSuffix, ",") {
+		t.Errorf("unexpected domain suffixes: %v", rule.DomainSuffix)
+	}
+	if strings.Join(rule.Domain, ",") != "ads.example.io,ads2.example.io,tracker.example.org" {
+		t.Errorf("unexpected domains: %v", rule.Domain)
+	}
+	if len(rule.DomainKeyword) != 1 || rule.DomainKeyword[0] != "doubleclick" {
+		t.Errorf("unexpected keywords: %v", rule.DomainKeyword)
+	}
+	if strings.Join(rule.IPCIDR, ",") != "10.0.0.0/8,192.168.1.10/32,2001:db8::/64" {
+		t.Errorf("unexpected cidrs: %v", rule.IPCIDR)
+	}
+	if len(list.Skipped) != 8 {
+		t.Errorf("unexpected skipped entries: %q", list.Skipped)
+	}
+}
+
+func TestRuleSetListDomainCase(t *testing.T) {
+	var list RuleSetList
+	if err := list.Parse("full:Tracker.Example.ORG\nkeyword:DoubleClick\nregexp:^Ads[0-9]+\\.example\\.com$\n", RuleSetListDomains); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Rule.Domain) != 1 || list.Rule.Domain[0] != "tracker.example.org" {
+		t.Errorf("unexpected full domains: %v", list.Rule.Domain)
+	}
+	if len(list.Rule.DomainKeyword) != 1 || list.Rule.DomainKeyword[0] != "doubleclick" {
+		t.Errorf("unexpected keywords: %v", list.Rule.DomainKeyword)
+	}
+	if len(list.Rule.DomainRegex) != 1 || list.Rule.DomainRegex[0] != `^Ads[0-9]+\.example\.com$` {
+		t.Errorf("regexp should keep its case: %v", list.Rule.DomainRegex)
+	}
+}
+
+func TestRuleSetListFixedFormat(t *testing.T) {
+	var list RuleSetList
+	if err := list.Parse("1.2.3.4\nexample.com\n", RuleSetListCIDR); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Rule.IPCIDR) != 1 || len(list.Skipped) != 1 {
+		t.Errorf("unexpected cidr list: %+v", list)
+	}
+	if err := list.Parse("example.com", "csv"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+	if _, err := (&RuleSetList{}).Compile(C.RuleSetFormatSource); err == nil {
+		t.Error("expected error for an empty list")
+	}
+}
+
+func TestSaveLocalRuleSet(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	opt.RuleSetDir = t.TempDir()
+	path, err := SaveLocalRuleSet(opt, "my-list", C.RuleSetFormatSource, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); err != nil || !strings.HasSuffix(path, "my-list.json") {
+		t.Fatalf("unexpected path %s: %v", path, err)
+	}
+	if _, err := SaveLocalRuleSet(opt, "../escape", C.RuleSetFormatSource, nil); err == nil {
+		t.Error("expected error for an invalid name")
+	}
+	sources, err := LocalRuleSets(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sources) != 1 || sources[0].URL != path || sources[0].Tag != ruleSetURLTag(path) || sources[0].Format != C.RuleSetFormatSource {
+		t.Errorf("unexpected local rule-sets: %+v", sources)
+	}
+	rule := Rule{RuleSetUrl: "file://" + path}
+	if linked := rule.RuleSetSources(); len(linked) != 1 || linked[0] != sources[0] {
+		t.Errorf("a file:// link should match the saved rule-set: %+v", linked)
+	}
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+)
+
+func TestRuleSetSources(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	opt.BlockAds = true
+	opt.Region = "ir"
+	opt.RuleSetMirror = "https://mirror.example.com/sets/"
+	opt.Rules = []Rule{
+		{RuleSetUrl: "https://example.com/list.srs"},
+		{RuleSetUrl: "https://example.com/list.srs"},
+		{RuleSetUrl: "/etc/hiddify/local.json"},
+	}
+	sources := RuleSetSources(opt)
+	if len(sources) != len(blockRuleSetTags)+2+2 {
+		t.Fatalf("unexpected sources: %+v", sources)
+	}
+	if sources[0].Tag != "geosite-ads" || sources[0].URL != "https://mirror.example.com/sets/block/geosite-category-ads-all.srs" {
+		t.Errorf("unexpected ads source: %+v", sources[0])
+	}
+	region := sources[len(blockRuleSetTags)]
+	if region.Tag != "geoip-ir" || region.URL != "https://mirror.example.com/sets/country/geoip-ir.srs" {
+		t.Errorf("unexpected region source: %+v", region)
+	}
+	local := sources[len(sources)-1]
+	if local.IsRemote() || local.Format != C.RuleSetFormatSource || local.FileName() != local.Tag+".json" {
+		t.Errorf("unexpected local source: %+v", local)
+	}
+}
+
+func TestMakeRuleSet(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	opt.RuleSetDir = t.TempDir()
+	cached := RuleSetSource{Tag: "geoip-ir", URL: "https://mirror.example.com/geoip-ir.srs", Format: C.RuleSetFormatBinary}
+	missing := RuleSetSource{Tag: "geosite-ir", URL: "https://mirror.example.com/geosite-ir.srs", Format: C.RuleSetFormatBinary}
+	files := StaticFiles{filepath.Join(opt.RuleSetDir, cached.FileName()): true}
+
+	ruleset := makeRuleSet(opt, files, cached)
+	if ruleset.Type != C.RuleSetTypeLocal || ruleset.LocalOptions.Path != filepath.Join(opt.RuleSetDir, "geoip-ir.srs") {
+		t.Errorf("cached rule-set should be local without a server: %+v", ruleset)
+	}
+	ruleset = makeRuleSet(opt, files, missing)
+	if ruleset.Type != C.RuleSetTypeRemote || ruleset.RemoteOptions.URL != missing.URL || ruleset.RemoteOptions.UpdateInterval == 0 {
+		t.Errorf("missing rule-set should be downloaded: %+v", ruleset)
+	}
+
+	opt.RuleSetServer = "http://127.0.0.1:1234"
+	ruleset = makeRuleSet(opt, files, cached)
+	if ruleset.Type != C.RuleSetTypeRemote || ruleset.RemoteOptions.URL != "http://127.0.0.1:1234/geoip-ir.srs" || ruleset.RemoteOptions.DownloadDetour != OutboundDirectTag {
+		t.Errorf("cached rule-set should come from the server: %+v", ruleset)
+	}
+	ruleset = makeRuleSet(opt, files, RuleSetSource{Tag: "local", URL: "/etc/hiddify/local.json", Format: C.RuleSetFormatSource})
+	if ruleset.Type != C.RuleSetTypeLocal || ruleset.LocalOptions.Path != "/etc/hiddify/local.json" {
+		t.Errorf("unexpected local rule-set: %+v", ruleset)
+	}
+}
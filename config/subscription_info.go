@@ -0,0 +1,129 @@
+package config
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SubscriptionInfo holds the metadata a subscription provider sends next to
+// the config, either as response headers or as `#key: value` comment lines.
+type SubscriptionInfo struct {
+	Upload     int64  `json:"upload"`
+	Download   int64  `json:"download"`
+	Total      int64  `json:"total"`
+	Expire     int64  `json:"expire"` // unix seconds, 0 when unlimited
+	Title      string `json:"title,omitempty"`
+	SupportURL string `json:"support-url,omitempty"`
+	WebPageURL string `json:"web-page-url,omitempty"`
+}
+
+func (s *SubscriptionInfo) Remaining() int64 {
+	if s.Total <= 0 {
+		return 0
+	}
+	remaining := s.Total - s.Upload - s.Download
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+func (s *SubscriptionInfo) Expired(now time.Time) bool {
+	return s.Expire > 0 && now.Unix() >= s.Expire
+}
+
+func (s *SubscriptionInfo) IsEmpty() bool {
+	return *s == SubscriptionInfo{}
+}
+
+// ParseSubscriptionInfo reads the subscription-userinfo, profile-title,
+// support-url and profile-web-page-url values. Headers take precedence over
+// values found in the body. It returns nil when none of them is present.
+func ParseSubscriptionInfo(header http.Header, body string) *SubscriptionInfo {
+	values := subscriptionBodyValues(body)
+	for _, key := range []string{"subscription-userinfo", "profile-title", "support-url", "profile-web-page-url"} {
+		if header == nil {
+			break
+		}
+		if value := header.Get(key); value != "" {
+			values[key] = value
+		}
+	}
+
+	info := &SubscriptionInfo{
+		Title:      decodeSubscriptionTitle(values["profile-title"]),
+		SupportURL: values["support-url"],
+		WebPageURL: values["profile-web-page-url"],
+	}
+	parseSubscriptionUserInfo(values["subscription-userinfo"], info)
+	if info.IsEmpty() {
+		return nil
+	}
+	return info
+}
+
+// parseSubscriptionUserInfo parses `upload=1; download=2; total=3; expire=4`.
+func parseSubscriptionUserInfo(value string, info *SubscriptionInfo) {
+	for _, part := range strings.Split(value, ";") {
+		key, val, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		if err != nil {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "upload":
+			info.Upload = int64(n)
+		case "download":
+			info.Download = int64(n)
+		case "total":
+			info.Total = int64(n)
+		case "expire":
+			info.Expire = int64(n)
+		}
+	}
+}
+
+func subscriptionBodyValues(body string) map[string]string {
+	values := map[string]string{}
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "//"):
+			line = line[2:]
+		case strings.HasPrefix(line, "#"):
+			line = line[1:]
+		default:
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if _, ok := values[key]; !ok {
+			values[key] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
+
+// decodeSubscriptionTitle handles the `base64:` prefix some panels use for
+// non-ascii titles.
+func decodeSubscriptionTitle(title string) string {
+	encoded, found := strings.CutPrefix(title, "base64:")
+	if !found {
+		return title
+	}
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(encoded); err == nil {
+			return string(decoded)
+		}
+	}
+	return title
+}
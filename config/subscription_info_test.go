@@ -0,0 +1,63 @@
+package config
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseSubscriptionInfoHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("subscription-userinfo", "upload=1024; download=2048; total=10240; expire=1700000000")
+	header.Set("profile-title", "base64:8J+agCBIaWRkaWZ5")
+	header.Set("support-url", "https://t.me/hiddify")
+	header.Set("profile-web-page-url", "https://example.com/panel")
+
+	info := ParseSubscriptionInfo(header, "")
+	if info == nil {
+		t.Fatal("expected subscription info")
+	}
+	if info.Upload != 1024 || info.Download != 2048 || info.Total != 10240 || info.Expire != 1700000000 {
+		t.Errorf("unexpected usage: %+v", info)
+	}
+	if info.Title != "🚀 Hiddify" {
+		t.Errorf("unexpected title: %q", info.Title)
+	}
+	if info.SupportURL != "https://t.me/hiddify" || info.WebPageURL != "https://example.com/panel" {
+		t.Errorf("unexpected urls: %+v", info)
+	}
+	if info.Remaining() != 10240-1024-2048 {
+		t.Errorf("unexpected remaining: %d", info.Remaining())
+	}
+	if !info.Expired(time.Unix(1700000000, 0)) {
+		t.Error("expected subscription to be expired")
+	}
+}
+
+func TestParseSubscriptionInfoBody(t *testing.T) {
+	body := "#profile-title: My Sub\n" +
+		"//subscription-userinfo: upload=0; download=5; total=0; expire=0\n" +
+		"vless://00000000-0000-0000-0000-000000000000@example.com:443#a\n"
+	header := http.Header{}
+	header.Set("profile-title", "From Header")
+
+	info := ParseSubscriptionInfo(header, body)
+	if info == nil {
+		t.Fatal("expected subscription info")
+	}
+	if info.Title != "From Header" {
+		t.Errorf("header should take precedence, got %q", info.Title)
+	}
+	if info.Download != 5 || info.Total != 0 || info.Remaining() != 0 {
+		t.Errorf("unexpected usage: %+v", info)
+	}
+	if info.Expired(time.Now()) {
+		t.Error("zero expire must never expire")
+	}
+}
+
+func TestParseSubscriptionInfoMissing(t *testing.T) {
+	if info := ParseSubscriptionInfo(nil, "vless://a@b:443"); info != nil {
+		t.Errorf("expected nil, got %+v", info)
+	}
+}
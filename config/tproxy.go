@@ -0,0 +1,259 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	dns "github.com/sagernet/sing-dns"
+	N "github.com/sagernet/sing/common/network"
+)
+
+const (
+	// TransparentProxyTProxy sends tcp and udp through a tproxy inbound.
+	TransparentProxyTProxy = "tproxy"
+	// TransparentProxyRedirect sends tcp through a redirect inbound and udp,
+	// which can not be redirected, through a tproxy inbound.
+	TransparentProxyRedirect = "redirect"
+
+	FirewallAuto     = "auto"
+	FirewallNFTables = "nftables"
+	FirewallIPTables = "iptables"
+	FirewallNone     = "none"
+
+	InboundTProxyTag   = "tproxy-in"
+	InboundRedirectTag = "redirect-in"
+
+	// the mark and routing table that deliver tproxy packets to the local
+	// listener
+	tproxyMark  = 1
+	tproxyTable = 100
+	// firewallChain is the nftables table and the iptables chain of the rules
+	firewallChain = "hiddify"
+)
+
+// transparentProxyBypass are the destinations that are never proxied:
+// private, loopback, link local, multicast and reserved ranges.
+var (
+	transparentProxyBypass4 = []string{
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"224.0.0.0/4",
+		"240.0.0.0/4",
+	}
+	transparentProxyBypass6 = []string{
+		"::/128",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+		"ff00::/8",
+	}
+)
+
+// FirewallCommand is one command of the firewall rules; Stdin is written to
+// its standard input.
+type FirewallCommand struct {
+	Args  []string
+	Stdin string
+}
+
+func (c FirewallCommand) String() string {
+	return strings.Join(c.Args, " ")
+}
+
+// FirewallRules are the commands that send the traffic of the LAN to the
+// transparent proxy inbounds and the commands that remove them again.
+type FirewallRules struct {
+	Apply  []FirewallCommand
+	Revert []FirewallCommand
+}
+
+func makeTransparentProxyInbounds(opt *HiddifyOptions, domainStrategy option.DomainStrategy) []option.Inbound {
+	if opt.TransparentProxy == "" || opt.TProxyPort == 0 {
+		return nil
+	}
+	listen := option.ListenOptions{
+		Listen:     option.NewListenAddress(netip.IPv6Unspecified()),
+		ListenPort: opt.TProxyPort,
+		InboundOptions: option.InboundOptions{
+			SniffEnabled:             true,
+			SniffOverrideDestination: false,
+			DomainStrategy:           domainStrategy,
+		},
+	}
+	tproxy := option.Inbound{
+		Type: C.TypeTProxy,
+		Tag:  InboundTProxyTag,
+		TProxyOptions: option.TProxyInboundOptions{
+			ListenOptions: listen,
+		},
+	}
+	if opt.TransparentProxy != TransparentProxyRedirect {
+		return []option.Inbound{tproxy}
+	}
+	tproxy.TProxyOptions.Network = option.NetworkList(N.NetworkUDP)
+	return []option.Inbound{
+		{
+			Type: C.TypeRedirect,
+			Tag:  InboundRedirectTag,
+			RedirectOptions: option.RedirectInboundOptions{
+				ListenOptions: listen,
+			},
+		},
+		tproxy,
+	}
+}
+
+func validateTransparentProxy(opt *HiddifyOptions) error {
+	switch opt.TransparentProxy {
+	case "", TransparentProxyTProxy, TransparentProxyRedirect:
+	default:
+		return fmt.Errorf("unknown transparent proxy mode %s", opt.TransparentProxy)
+	}
+	switch opt.TransparentProxyFirewall {
+	case "", FirewallAuto, FirewallNFTables, FirewallIPTables, FirewallNone:
+	default:
+		return fmt.Errorf("unknown firewall %s", opt.TransparentProxyFirewall)
+	}
+	return nil
+}
+
+func transparentProxyIPv6(opt *HiddifyOptions) bool {
+	return opt.IPv6Mode != option.DomainStrategy(dns.DomainStrategyUseIPv4)
+}
+
+// MakeFirewallRules returns the nftables or iptables rules for the
+// transparent proxy of opt. The rules only catch traffic routed through the
+// host, so the connections of the core itself are never looped back.
+func MakeFirewallRules(opt *HiddifyOptions, firewall string) (FirewallRules, error) {
+	var rules FirewallRules
+	if err := validateTransparentProxy(opt); err != nil {
+		return rules, err
+	}
+	if opt.TransparentProxy == "" || opt.TProxyPort == 0 {
+		return rules, fmt.Errorf("transparent proxy is not enabled")
+	}
+	ipv6 := transparentProxyIPv6(opt)
+	families := []string{"-4"}
+	if ipv6 {
+		families = append(families, "-6")
+	}
+	for _, family := range families {
+		local := "0.0.0.0/0"
+		if family == "-6" {
+			local = "::/0"
+		}
+		mark, table := strconv.Itoa(tproxyMark), strconv.Itoa(tproxyTable)
+		rules.Apply = append(rules.Apply,
+			FirewallCommand{Args: []string{"ip", family, "rule", "add", "fwmark", mark, "table", table}},
+			FirewallCommand{Args: []string{"ip", family, "route", "add", "local", local, "dev", "lo", "table", table}},
+		)
+		rules.Revert = append(rules.Revert,
+			FirewallCommand{Args: []string{"ip", family, "rule", "del", "fwmark", mark, "table", table}},
+			FirewallCommand{Args: []string{"ip", family, "route", "del", "local", local, "dev", "lo", "table", table}},
+		)
+	}
+
+	switch firewall {
+	case FirewallNFTables:
+		rules.Apply = append(rules.Apply, FirewallCommand{Args: []string{"nft", "-f", "-"}, Stdin: nftablesScript(opt, ipv6)})
+		rules.Revert = append(rules.Revert, FirewallCommand{Args: []string{"nft", "delete", "table", "inet", firewallChain}})
+	case FirewallIPTables:
+		commands := []string{"iptables"}
+		if ipv6 {
+			commands = append(commands, "ip6tables")
+		}
+		for _, command := range commands {
+			apply, revert := iptablesCommands(opt, command)
+			rules.Apply = append(rules.Apply, apply...)
+			rules.Revert = append(rules.Revert, revert...)
+		}
+	default:
+		return rules, fmt.Errorf("unknown firewall %s", firewall)
+	}
+	return rules, nil
+}
+
+func nftablesScript(opt *HiddifyOptions, ipv6 bool) string {
+	port := strconv.Itoa(int(opt.TProxyPort))
+	mark := strconv.Itoa(tproxyMark)
+	var script strings.Builder
+	script.WriteString("table inet " + firewallChain + " {\n")
+	script.WriteString("\tset bypass4 {\n\t\ttype ipv4_addr\n\t\tflags interval\n\t\telements = { " + strings.Join(transparentProxyBypass4, ", ") + " }\n\t}\n")
+	if ipv6 {
+		script.WriteString("\tset bypass6 {\n\t\ttype ipv6_addr\n\t\tflags interval\n\t\telements = { " + strings.Join(transparentProxyBypass6, ", ") + " }\n\t}\n")
+	}
+	// addresses of the host itself, e.g. its public address
+	bypass := "\t\tfib daddr type local return\n"
+	bypass += "\t\tip daddr @bypass4 return\n"
+	if ipv6 {
+		bypass += "\t\tip6 daddr @bypass6 return\n"
+	} else {
+		bypass += "\t\tmeta nfproto ipv6 return\n"
+	}
+
+	tproxyProtocols := "{ tcp, udp }"
+	if opt.TransparentProxy == TransparentProxyRedirect {
+		tproxyProtocols = "udp"
+		script.WriteString("\tchain redirect {\n\t\ttype nat hook prerouting priority dstnat; policy accept;\n")
+		script.WriteString(bypass)
+		script.WriteString("\t\tmeta l4proto tcp redirect to :" + port + "\n\t}\n")
+	}
+	script.WriteString("\tchain tproxy {\n\t\ttype filter hook prerouting priority mangle; policy accept;\n")
+	script.WriteString(bypass)
+	script.WriteString("\t\tmeta l4proto " + tproxyProtocols + " meta mark set " + mark + " tproxy to :" + port + " accept\n\t}\n")
+	script.WriteString("}\n")
+	return script.String()
+}
+
+func iptablesCommands(opt *HiddifyOptions, command string) ([]FirewallCommand, []FirewallCommand) {
+	port := strconv.Itoa(int(opt.TProxyPort))
+	mark := strconv.Itoa(tproxyMark)
+	chain := strings.ToUpper(firewallChain)
+	bypass := transparentProxyBypass4
+	if command == "ip6tables" {
+		bypass = transparentProxyBypass6
+	}
+	tables := []string{"mangle"}
+	protocols := []string{"tcp", "udp"}
+	if opt.TransparentProxy == TransparentProxyRedirect {
+		tables = append(tables, "nat")
+		protocols = []string{"udp"}
+	}
+
+	var apply, revert []FirewallCommand
+	for _, table := range tables {
+		rule := func(args ...string) FirewallCommand {
+			return FirewallCommand{Args: append([]string{command, "-t", table}, args...)}
+		}
+		apply = append(apply,
+			rule("-N", chain),
+			rule("-A", chain, "-m", "addrtype", "--dst-type", "LOCAL", "-j", "RETURN"),
+		)
+		for _, cidr := range bypass {
+			apply = append(apply, rule("-A", chain, "-d", cidr, "-j", "RETURN"))
+		}
+		if table == "nat" {
+			apply = append(apply, rule("-A", chain, "-p", "tcp", "-j", "REDIRECT", "--to-ports", port))
+		} else {
+			for _, protocol := range protocols {
+				apply = append(apply, rule("-A", chain, "-p", protocol, "-j", "TPROXY", "--on-port", port, "--tproxy-mark", mark))
+			}
+		}
+		apply = append(apply, rule("-A", "PREROUTING", "-j", chain))
+		revert = append(revert,
+			rule("-D", "PREROUTING", "-j", chain),
+			rule("-F", chain),
+			rule("-X", chain),
+		)
+	}
+	return apply, revert
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	dns "github.com/sagernet/sing-dns"
+)
+
+func TestTransparentProxyInbounds(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	if inbounds := makeTransparentProxyInbounds(opt, opt.IPv6Mode); len(inbounds) != 0 {
+		t.Fatalf("transparent proxy should be disabled by default: %+v", inbounds)
+	}
+	opt.TransparentProxy = TransparentProxyTProxy
+	inbounds := makeTransparentProxyInbounds(opt, opt.IPv6Mode)
+	if len(inbounds) != 1 || inbounds[0].Type != C.TypeTProxy || inbounds[0].TProxyOptions.ListenPort != opt.TProxyPort {
+		t.Fatalf("unexpected tproxy inbounds: %+v", inbounds)
+	}
+	opt.TransparentProxy = TransparentProxyRedirect
+	inbounds = makeTransparentProxyInbounds(opt, opt.IPv6Mode)
+	if len(inbounds) != 2 || inbounds[0].Type != C.TypeRedirect || inbounds[1].TProxyOptions.Network != "udp" {
+		t.Fatalf("unexpected redirect inbounds: %+v", inbounds)
+	}
+}
+
+func TestMakeFirewallRules(t *testing.T) {
+	opt := DefaultHiddifyOptions()
+	if _, err := MakeFirewallRules(opt, FirewallNFTables); err == nil {
+		t.Error("expected error without transparent proxy")
+	}
+	opt.TransparentProxy = TransparentProxyTProxy
+	rules, err := MakeFirewallRules(opt, FirewallNFTables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := rules.Apply[len(rules.Apply)-1].Stdin
+	for _, want := range []string{"fib daddr type local return", "192.168.0.0/16", "fc00::/7", "tproxy to :12335", "{ tcp, udp }"} {
+		if !strings.Contains(script, want) {
+			t.Errorf("nftables script is missing %q:\n%s", want, script)
+		}
+	}
+	if last := rules.Revert[len(rules.Revert)-1].String(); last != "nft delete table inet hiddify" {
+		t.Errorf("unexpected revert: %s", last)
+	}
+
+	opt.TransparentProxy = TransparentProxyRedirect
+	opt.IPv6Mode = option.DomainStrategy(dns.DomainStrategyUseIPv4)
+	rules, err = MakeFirewallRules(opt, FirewallIPTables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var apply []string
+	for _, command := range rules.Apply {
+		apply = append(apply, command.String())
+		if command.Args[0] == "ip6tables" || strings.Contains(command.String(), "::/0") {
+			t.Errorf("ipv6 rules without ipv6: %s", command)
+		}
+	}
+	joined := strings.Join(apply, "\n")
+	for _, want := range []string{
+		"iptables -t nat -A HIDDIFY -p tcp -j REDIRECT --to-ports 12335",
+		"iptables -t mangle -A HIDDIFY -p udp -j TPROXY --on-port 12335 --tproxy-mark 1",
+		"iptables -t mangle -A HIDDIFY -m addrtype --dst-type LOCAL -j RETURN",
+		"iptables -t nat -A HIDDIFY -m addrtype --dst-type LOCAL -j RETURN",
+		"iptables -t mangle -A PREROUTING -j HIDDIFY",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("iptables rules are missing %q", want)
+		}
+	}
+	if strings.Contains(joined, "-p tcp -j TPROXY") {
+		t.Error("tcp should be redirected, not tproxied")
+	}
+
+	opt.TransparentProxy = "divert"
+	if _, err := MakeFirewallRules(opt, FirewallIPTables); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
@@ -3,13 +3,13 @@ package config
 import (
 	"encoding/base64"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/netip"
 	"os"
 	"strings"
 
 	"github.com/bepass-org/warp-plus/warp"
-	"github.com/hiddify/hiddify-core/v2/common"
 	C "github.com/sagernet/sing-box/constant"
 
 	// "github.com/bepass-org/wireguard-go/warp"
@@ -103,7 +103,6 @@ func GenerateWarpSingbox(wgConfig WarpWireguardConfig, host string, port uint16,
 	}
 	singboxConfig, err := wireGuardToSingbox(wgConfig, host, port)
 	if err != nil {
-		fmt.Printf("%v %v", singboxConfig, err)
 		return nil, err
 	}
 
@@ -116,15 +115,20 @@ func GenerateWarpSingbox(wgConfig WarpWireguardConfig, host string, port uint16,
 }
 
 func GenerateWarpInfo(license string, oldAccountId string, oldAccessToken string) (*warp.Identity, string, *WarpWireguardConfig, error) {
+	return generateWarpInfo(os.Stdout, license, oldAccountId, oldAccessToken)
+}
+
+// generateWarpInfo is GenerateWarpInfo logging the registration to logOutput.
+func generateWarpInfo(logOutput io.Writer, license string, oldAccountId string, oldAccessToken string) (*warp.Identity, string, *WarpWireguardConfig, error) {
 	if oldAccountId != "" && oldAccessToken != "" {
 		err := warp.DeleteDevice(oldAccessToken, oldAccountId)
 		if err != nil {
-			fmt.Printf("Error in removing old device: %v\n", err)
+			fmt.Fprintf(logOutput, "Error in removing old device: %v\n", err)
 		} else {
-			fmt.Printf("Old Device Removed")
+			fmt.Fprintf(logOutput, "Old Device Removed")
 		}
 	}
-	l := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	l := slog.New(slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelInfo}))
 	identity, err := warp.CreateIdentityOnly(l, license)
 	res := "Error!"
 	var warpcfg WarpWireguardConfig
@@ -144,14 +148,20 @@ func GenerateWarpInfo(license string, oldAccountId string, oldAccessToken string
 	return &identity, res, &warpcfg, err
 }
 
-func getOrGenerateWarpLocallyIfNeeded(warpOptions *WarpOptions) WarpWireguardConfig {
+// getOrGenerateWarpLocallyIfNeeded returns the config of a saved warp
+// account and registers one when there is none. An account taken from the
+// vault is never registered again, a missing vault entry is an error.
+func getOrGenerateWarpLocallyIfNeeded(warpOptions *WarpOptions, logOutput io.Writer) (WarpWireguardConfig, error) {
+	if warpOptions.VaultId != "" {
+		return WarpVaultConfig(warpOptions.VaultId)
+	}
 	if warpOptions.WireguardConfig.PrivateKey != "" {
-		return warpOptions.WireguardConfig
+		return warpOptions.WireguardConfig, nil
 	}
 	table := db.GetTable[WarpOptions]()
 	dbWarpOptions, err := table.Get(warpOptions.Id)
 	if err == nil && dbWarpOptions.WireguardConfig.PrivateKey != "" {
-		return warpOptions.WireguardConfig
+		return warpOptions.WireguardConfig, nil
 	}
 	license := ""
 	if len(warpOptions.Id) == 26 { // warp key is 26 characters long
@@ -160,9 +170,9 @@ func getOrGenerateWarpLocallyIfNeeded(warpOptions *WarpOptions) WarpWireguardCon
 		license = warpOptions.Id[3:]
 	}
 
-	accountidentity, _, wireguardConfig, err := GenerateWarpInfo(license, warpOptions.Account.AccountID, warpOptions.Account.AccessToken)
+	accountidentity, _, wireguardConfig, err := generateWarpInfo(logOutput, license, warpOptions.Account.AccountID, warpOptions.Account.AccessToken)
 	if err != nil {
-		return WarpWireguardConfig{}
+		return WarpWireguardConfig{}, nil
 	}
 	warpOptions.Account = WarpAccount{
 		AccountID:   accountidentity.ID,
@@ -171,10 +181,12 @@ func getOrGenerateWarpLocallyIfNeeded(warpOptions *WarpOptions) WarpWireguardCon
 	warpOptions.WireguardConfig = *wireguardConfig
 	table.UpdateInsert(warpOptions)
 
-	return *wireguardConfig
+	return *wireguardConfig, nil
 }
 
-func patchWarp(base *option.Outbound, configOpt *HiddifyOptions, final bool, staticIpsDns map[string][]string) error {
+// patchWarp fills in a warp outbound. A final patch picks its endpoint
+// through b, which is only used then.
+func patchWarp(base *option.Outbound, configOpt *HiddifyOptions, final bool, staticIpsDns map[string][]string, b *build) error {
 	if base.Type == C.TypeCustom {
 		if warp, ok := base.CustomOptions["warp"].(map[string]interface{}); ok {
 			key, _ := warp["key"].(string)
@@ -189,12 +201,24 @@ func patchWarp(base *option.Outbound, configOpt *HiddifyOptions, final bool, sta
 			var err error
 
 			is_saved_key := len(key) > 1 && key[0] == 'p'
+			vaultId, is_vault_key := warpVaultId(key)
 
-			if (configOpt == nil || !final) && is_saved_key {
+			if (configOpt == nil || !final) && is_saved_key || !final && is_vault_key {
 				return nil
 			}
+			// a parsed config is not final and has no build, but can still
+			// carry a license key
+			accounts := DefaultBuildEnv().Warp
+			if b != nil {
+				accounts = b.Warp
+			}
 			var wireguardConfig WarpWireguardConfig
-			if is_saved_key {
+			if is_vault_key {
+				wireguardConfig, err = accounts.Vault(vaultId)
+				if err != nil {
+					return err
+				}
+			} else if is_saved_key {
 				var warpOpt *WarpOptions
 				if key == "p1" {
 					warpOpt = &configOpt.Warp
@@ -207,17 +231,18 @@ func patchWarp(base *option.Outbound, configOpt *HiddifyOptions, final bool, sta
 				}
 				warpOpt.Id = key
 
-				wireguardConfig = getOrGenerateWarpLocallyIfNeeded(warpOpt)
+				wireguardConfig, err = accounts.Saved(warpOpt)
+				if err != nil {
+					return err
+				}
 			} else {
-				_, _, wgConfig, err := GenerateWarpInfo(key, "", "")
+				wireguardConfig, err = accounts.Register(key)
 				if err != nil {
 					return err
 				}
-				wireguardConfig = *wgConfig
 			}
 			warpOutbound, err = GenerateWarpSingbox(wireguardConfig, host, port, fakePackets, fakePacketsSize, fakePacketsDelay, fakePacketsMode)
 			if err != nil {
-				fmt.Printf("Error generating warp config: %v", err)
 				return err
 			}
 			warpOutbound.WireGuardOptions.Detour = detour
@@ -229,27 +254,27 @@ func patchWarp(base *option.Outbound, configOpt *HiddifyOptions, final bool, sta
 	if final && base.Type == C.TypeWireGuard {
 		host := base.WireGuardOptions.Server
 
-		if host == "default" || host == "random" || host == "auto" || host == "auto4" || host == "auto6" || isBlockedDomain(host) {
+		if host == "default" || host == "random" || host == "auto" || host == "auto4" || host == "auto6" || isBlockedDomain(b.Prober, host) {
 			// if base.WireGuardOptions.Detour != "" {
 			// 	base.WireGuardOptions.Server = "162.159.192.1"
 			// } else {
-			rndDomain := strings.ToLower(generateRandomString(20))
+			rndDomain := strings.ToLower(b.Random.String(20))
 			staticIpsDns[rndDomain] = []string{}
 			if host != "auto4" {
-				if host == "auto6" || common.CanConnectIPv6() {
-					randomIpPort, _ := warp.RandomWarpEndpoint(false, true)
+				if host == "auto6" || b.Prober.CanConnectIPv6() {
+					randomIpPort, _ := b.Random.WarpEndpoint(false, true)
 					staticIpsDns[rndDomain] = append(staticIpsDns[rndDomain], randomIpPort.Addr().String())
 				}
 			}
 			if host != "auto6" {
-				randomIpPort, _ := warp.RandomWarpEndpoint(true, false)
+				randomIpPort, _ := b.Random.WarpEndpoint(true, false)
 				staticIpsDns[rndDomain] = append(staticIpsDns[rndDomain], randomIpPort.Addr().String())
 			}
 			base.WireGuardOptions.Server = rndDomain
 			// }
 		}
 		if base.WireGuardOptions.ServerPort == 0 {
-			port := warp.RandomWarpPort()
+			port := b.Random.WarpPort()
 			base.WireGuardOptions.ServerPort = port
 		}
 
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bepass-org/warp-plus/warp"
+	"github.com/hiddify/hiddify-core/v2/db"
+)
+
+// WarpVaultKeyPrefix marks a warp key that references a vault account by id,
+// as in warp://vault-<id>@auto.
+const WarpVaultKeyPrefix = "vault-"
+
+func WarpVaultKey(id string) string {
+	return WarpVaultKeyPrefix + id
+}
+
+func newWarpVaultId() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprint(time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// ListWarpVault returns the vault accounts, oldest first.
+func ListWarpVault() ([]*db.WarpVaultEntry, error) {
+	entries, err := db.WarpVault().All()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].CreatedAt.Before(entries[j].CreatedAt) })
+	return entries, nil
+}
+
+func GetWarpVaultEntry(id string) (*db.WarpVaultEntry, error) {
+	entry, err := db.WarpVault().Get(id)
+	if err != nil || entry.Id == "" {
+		return nil, fmt.Errorf("warp account %s not found", id)
+	}
+	return entry, nil
+}
+
+// CreateWarpVaultEntry registers a new warp device, with a WARP+ license if
+// given, and keeps it in the vault.
+func CreateWarpVaultEntry(name string, license string) (*db.WarpVaultEntry, error) {
+	identity, _, _, err := GenerateWarpInfo(license, "", "")
+	if err != nil {
+		return nil, err
+	}
+	entry := &db.WarpVaultEntry{Id: newWarpVaultId(), Name: name, CreatedAt: time.Now()}
+	setWarpVaultIdentity(entry, identity)
+	return entry, db.WarpVault().UpdateInsert(entry)
+}
+
+// ImportWarpVaultEntry keeps an existing warp device in the vault. Without
+// its private key, the device gets a new key pair.
+func ImportWarpVaultEntry(name string, deviceID string, accessToken string, privateKey string) (*db.WarpVaultEntry, error) {
+	if deviceID == "" || accessToken == "" {
+		return nil, fmt.Errorf("account id and access token are required")
+	}
+	entry := &db.WarpVaultEntry{Id: newWarpVaultId(), Name: name, DeviceID: deviceID, AccessToken: accessToken, CreatedAt: time.Now()}
+	if privateKey == "" {
+		if err := rotateWarpVaultKey(entry); err != nil {
+			return nil, err
+		}
+	} else {
+		identity, err := warp.GetSourceDevice(accessToken, deviceID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch warp device: %w", err)
+		}
+		identity.PrivateKey = privateKey
+		setWarpVaultIdentity(entry, &identity)
+	}
+	return entry, db.WarpVault().UpdateInsert(entry)
+}
+
+// BindWarpVaultLicense moves the account to a WARP+ license.
+func BindWarpVaultLicense(id string, license string) (*db.WarpVaultEntry, error) {
+	entry, err := GetWarpVaultEntry(id)
+	if err != nil {
+		return nil, err
+	}
+	account, err := warp.UpdateAccount(entry.AccessToken, entry.DeviceID, license)
+	if err != nil {
+		return nil, fmt.Errorf("bind license: %w", err)
+	}
+	setWarpVaultAccount(entry, account)
+	if entry.License == "" {
+		entry.License = license
+	}
+	return entry, db.WarpVault().UpdateInsert(entry)
+}
+
+// RotateWarpVaultEntry gives the device a new key pair. The account, its
+// license and the outbounds that reference it stay the same.
+func RotateWarpVaultEntry(id string) (*db.WarpVaultEntry, error) {
+	entry, err := GetWarpVaultEntry(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := rotateWarpVaultKey(entry); err != nil {
+		return nil, err
+	}
+	return entry, db.WarpVault().UpdateInsert(entry)
+}
+
+func rotateWarpVaultKey(entry *db.WarpVaultEntry) error {
+	key, err := warp.GeneratePrivateKey()
+	if err != nil {
+		return err
+	}
+	identity, err := warp.UpdateSourceDevice(entry.AccessToken, entry.DeviceID, key.PublicKey().String())
+	if err != nil {
+		return fmt.Errorf("rotate warp key: %w", err)
+	}
+	identity.PrivateKey = key.String()
+	setWarpVaultIdentity(entry, &identity)
+	entry.RotatedAt = time.Now()
+	return nil
+}
+
+// DeleteWarpVaultEntry removes the account from the vault and, unless
+// keepDevice, its device from warp.
+func DeleteWarpVaultEntry(id string, keepDevice bool) error {
+	entry, err := GetWarpVaultEntry(id)
+	if err != nil {
+		return err
+	}
+	if !keepDevice {
+		if err := warp.DeleteDevice(entry.AccessToken, entry.DeviceID); err != nil {
+			return fmt.Errorf("remove warp device: %w", err)
+		}
+	}
+	return db.WarpVault().Delete(id)
+}
+
+// WarpVaultConfig returns the wireguard config of a vault account.
+func WarpVaultConfig(id string) (WarpWireguardConfig, error) {
+	entry, err := GetWarpVaultEntry(id)
+	if err != nil {
+		return WarpWireguardConfig{}, err
+	}
+	return WarpWireguardConfig{
+		PrivateKey:       entry.PrivateKey,
+		LocalAddressIPv4: entry.LocalAddressIPv4,
+		LocalAddressIPv6: entry.LocalAddressIPv6,
+		PeerPublicKey:    entry.PeerPublicKey,
+		ClientID:         entry.ClientID,
+	}, nil
+}
+
+// setWarpVaultIdentity copies a registration into entry. Registrations
+// returned by updates may lack some parts, which are kept.
+func setWarpVaultIdentity(entry *db.WarpVaultEntry, identity *warp.Identity) {
+	if identity.ID != "" {
+		entry.DeviceID = identity.ID
+	}
+	if identity.Token != "" {
+		entry.AccessToken = identity.Token
+	}
+	entry.PrivateKey = identity.PrivateKey
+	if len(identity.Config.Peers) > 0 {
+		entry.PeerPublicKey = identity.Config.Peers[0].PublicKey
+	}
+	if addresses := identity.Config.Interface.Addresses; addresses.V4 != "" || addresses.V6 != "" {
+		entry.LocalAddressIPv4 = addresses.V4
+		entry.LocalAddressIPv6 = addresses.V6
+	}
+	if identity.Config.ClientID != "" {
+		entry.ClientID = identity.Config.ClientID
+	}
+	if identity.Account.ID != "" {
+		setWarpVaultAccount(entry, identity.Account)
+	}
+}
+
+func setWarpVaultAccount(entry *db.WarpVaultEntry, account warp.IdentityAccount) {
+	entry.License = account.License
+	entry.AccountType = account.AccountType
+	entry.WarpPlus = account.WarpPlus
+}
+
+// warpVaultId returns the vault id referenced by a warp key.
+func warpVaultId(key string) (string, bool) {
+	id, ok := strings.CutPrefix(key, WarpVaultKeyPrefix)
+	return id, ok && id != ""
+}
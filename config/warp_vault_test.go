@@ -0,0 +1,56 @@
+package config
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hiddify/hiddify-core/v2/db"
+)
+
+func TestWarpVault(t *testing.T) {
+	t.Chdir(t.TempDir())
+	entry := &db.WarpVaultEntry{
+		Id:               "a1b2c3",
+		Name:             "main",
+		DeviceID:         "device",
+		AccessToken:      "token",
+		PrivateKey:       "private",
+		PeerPublicKey:    "peer",
+		LocalAddressIPv4: "172.16.0.2",
+		ClientID:         "AAAA",
+		CreatedAt:        time.Now(),
+	}
+	if err := db.WarpVault().UpdateInsert(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	id, ok := warpVaultId(WarpVaultKey(entry.Id))
+	if !ok || id != entry.Id {
+		t.Fatalf("unexpected vault id: %q %v", id, ok)
+	}
+	if _, ok := warpVaultId("p1"); ok {
+		t.Error("p1 is not a vault key")
+	}
+	wireguardConfig, err := WarpVaultConfig(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wireguardConfig.PrivateKey != "private" || wireguardConfig.PeerPublicKey != "peer" || wireguardConfig.LocalAddressIPv4 != "172.16.0.2" {
+		t.Errorf("unexpected config: %+v", wireguardConfig)
+	}
+	entries, err := ListWarpVault()
+	if err != nil || len(entries) != 1 || entries[0].Name != "main" {
+		t.Errorf("unexpected entries: %v %v", entries, err)
+	}
+
+	if err := DeleteWarpVaultEntry(id, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := WarpVaultConfig(id); err == nil {
+		t.Error("expected deleted account to be missing")
+	}
+	if _, err := getOrGenerateWarpLocallyIfNeeded(&WarpOptions{Id: "p1", VaultId: id}, io.Discard); err == nil {
+		t.Error("expected a missing vault account to fail instead of registering a new one")
+	}
+}
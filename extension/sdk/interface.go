@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/hiddify/hiddify-core/config"
 	v2 "github.com/hiddify/hiddify-core/v2"
+	"github.com/hiddify/hiddify-core/v2/servertest"
 	"github.com/sagernet/sing-box/option"
 )
 
@@ -16,10 +18,32 @@ func RunInstance(hiddifySettings *config.HiddifyOptions, singconfig *option.Opti
 	return v2.RunInstance(hiddifySettings, singconfig)
 }
 
+// RunEmbeddedInstance starts an instance without a local proxy. Connect
+// through it with its DialContext, ListenPacket or HTTPClient, or pin them to
+// an outbound with Dialer(tag).
+func RunEmbeddedInstance(hiddifySettings *config.HiddifyOptions, singconfig *option.Options) (*v2.HiddifyService, error) {
+	return v2.RunEmbeddedInstance(hiddifySettings, singconfig)
+}
+
+// SpeedTest measures latency, jitter and download and upload throughput
+// through an instance. Use instance.Dialer(tag).SpeedTest to test a single
+// outbound.
+func SpeedTest(ctx context.Context, instance *v2.HiddifyService, options servertest.SpeedOptions) (servertest.SpeedResult, error) {
+	return instance.SpeedTest(ctx, options)
+}
+
 func ParseConfig(hiddifySettings *config.HiddifyOptions, configStr string) (*option.Options, error) {
+	options, _, err := ParseConfigWithInfo(hiddifySettings, configStr)
+	return options, err
+}
+
+// ParseConfigWithInfo is like ParseConfig but also returns the subscription
+// quota, expiry and title sent by the provider, if any.
+func ParseConfigWithInfo(hiddifySettings *config.HiddifyOptions, configStr string) (*option.Options, *config.SubscriptionInfo, error) {
 	if hiddifySettings == nil {
 		hiddifySettings = config.DefaultHiddifyOptions()
 	}
+	var header http.Header
 	if strings.HasPrefix(configStr, "http://") || strings.HasPrefix(configStr, "https://") {
 		client := &http.Client{}
 		configPath := strings.Split(configStr, "\n")[0]
@@ -27,21 +51,27 @@ func ParseConfig(hiddifySettings *config.HiddifyOptions, configStr string) (*opt
 		req, err := http.NewRequest("GET", configPath, nil)
 		if err != nil {
 			fmt.Println("Error creating request:", err)
-			return nil, err
+			return nil, nil, err
 		}
 		req.Header.Set("User-Agent", "HiddifyNext/2.3.1 ("+runtime.GOOS+") like ClashMeta v2ray sing-box")
 		resp, err := client.Do(req)
 		if err != nil {
 			fmt.Println("Error making GET request:", err)
-			return nil, err
+			return nil, nil, err
 		}
 		defer resp.Body.Close()
 
 		body, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read config body: %w", err)
+			return nil, nil, fmt.Errorf("failed to read config body: %w", err)
 		}
 		configStr = string(body)
+		header = resp.Header
+	}
+	info := config.ParseSubscriptionInfo(header, configStr)
+	options, err := config.ParseConfigContentToOptions(configStr, true, hiddifySettings, false)
+	if err != nil {
+		return nil, nil, err
 	}
-	return config.ParseConfigContentToOptions(configStr, true, hiddifySettings, false)
+	return options, info, nil
 }
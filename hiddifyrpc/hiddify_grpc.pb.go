@@ -162,6 +162,7 @@ const (
 	Core_Setup_FullMethodName                 = "/hiddifyrpc.Core/Setup"
 	Core_Parse_FullMethodName                 = "/hiddifyrpc.Core/Parse"
 	Core_ChangeHiddifySettings_FullMethodName = "/hiddifyrpc.Core/ChangeHiddifySettings"
+	Core_ReloadHiddifySettings_FullMethodName = "/hiddifyrpc.Core/ReloadHiddifySettings"
 	Core_StartService_FullMethodName          = "/hiddifyrpc.Core/StartService"
 	Core_Stop_FullMethodName                  = "/hiddifyrpc.Core/Stop"
 	Core_Restart_FullMethodName               = "/hiddifyrpc.Core/Restart"
@@ -171,6 +172,33 @@ const (
 	Core_GetSystemProxyStatus_FullMethodName  = "/hiddifyrpc.Core/GetSystemProxyStatus"
 	Core_SetSystemProxyEnabled_FullMethodName = "/hiddifyrpc.Core/SetSystemProxyEnabled"
 	Core_LogListener_FullMethodName           = "/hiddifyrpc.Core/LogListener"
+	Core_AddProfile_FullMethodName            = "/hiddifyrpc.Core/AddProfile"
+	Core_ListProfiles_FullMethodName          = "/hiddifyrpc.Core/ListProfiles"
+	Core_RefreshProfile_FullMethodName        = "/hiddifyrpc.Core/RefreshProfile"
+	Core_ActivateProfile_FullMethodName       = "/hiddifyrpc.Core/ActivateProfile"
+	Core_DeleteProfile_FullMethodName         = "/hiddifyrpc.Core/DeleteProfile"
+	Core_GetSubscriptionInfo_FullMethodName   = "/hiddifyrpc.Core/GetSubscriptionInfo"
+	Core_Export_FullMethodName                = "/hiddifyrpc.Core/Export"
+	Core_OutboundHealthInfo_FullMethodName    = "/hiddifyrpc.Core/OutboundHealthInfo"
+	Core_Connections_FullMethodName           = "/hiddifyrpc.Core/Connections"
+	Core_CloseConnection_FullMethodName       = "/hiddifyrpc.Core/CloseConnection"
+	Core_QueryTraffic_FullMethodName          = "/hiddifyrpc.Core/QueryTraffic"
+	Core_ListRuleSets_FullMethodName          = "/hiddifyrpc.Core/ListRuleSets"
+	Core_UpdateRuleSets_FullMethodName        = "/hiddifyrpc.Core/UpdateRuleSets"
+	Core_RemoveRuleSets_FullMethodName        = "/hiddifyrpc.Core/RemoveRuleSets"
+	Core_ExplainRoute_FullMethodName          = "/hiddifyrpc.Core/ExplainRoute"
+	Core_CreateInstance_FullMethodName        = "/hiddifyrpc.Core/CreateInstance"
+	Core_ListInstances_FullMethodName         = "/hiddifyrpc.Core/ListInstances"
+	Core_InspectInstance_FullMethodName       = "/hiddifyrpc.Core/InspectInstance"
+	Core_DestroyInstance_FullMethodName       = "/hiddifyrpc.Core/DestroyInstance"
+	Core_InstanceLogs_FullMethodName          = "/hiddifyrpc.Core/InstanceLogs"
+	Core_SpeedTest_FullMethodName             = "/hiddifyrpc.Core/SpeedTest"
+	Core_ListWarpAccounts_FullMethodName      = "/hiddifyrpc.Core/ListWarpAccounts"
+	Core_CreateWarpAccount_FullMethodName     = "/hiddifyrpc.Core/CreateWarpAccount"
+	Core_ImportWarpAccount_FullMethodName     = "/hiddifyrpc.Core/ImportWarpAccount"
+	Core_BindWarpLicense_FullMethodName       = "/hiddifyrpc.Core/BindWarpLicense"
+	Core_RotateWarpAccount_FullMethodName     = "/hiddifyrpc.Core/RotateWarpAccount"
+	Core_DeleteWarpAccount_FullMethodName     = "/hiddifyrpc.Core/DeleteWarpAccount"
 )
 
 // CoreClient is the client API for Core service.
@@ -185,6 +213,7 @@ type CoreClient interface {
 	Setup(ctx context.Context, in *SetupRequest, opts ...grpc.CallOption) (*Response, error)
 	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
 	ChangeHiddifySettings(ctx context.Context, in *ChangeHiddifySettingsRequest, opts ...grpc.CallOption) (*CoreInfoResponse, error)
+	ReloadHiddifySettings(ctx context.Context, in *ChangeHiddifySettingsRequest, opts ...grpc.CallOption) (*ReloadSettingsResponse, error)
 	//rpc GenerateConfig (GenerateConfigRequest) returns (GenerateConfigResponse);
 	StartService(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*CoreInfoResponse, error)
 	Stop(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CoreInfoResponse, error)
@@ -195,6 +224,33 @@ type CoreClient interface {
 	GetSystemProxyStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SystemProxyStatus, error)
 	SetSystemProxyEnabled(ctx context.Context, in *SetSystemProxyEnabledRequest, opts ...grpc.CallOption) (*Response, error)
 	LogListener(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogMessage], error)
+	AddProfile(ctx context.Context, in *AddProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	ListProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProfileList, error)
+	RefreshProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	ActivateProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	DeleteProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Response, error)
+	GetSubscriptionInfo(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SubscriptionInfoResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	OutboundHealthInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OutboundHealthList], error)
+	Connections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConnectionList], error)
+	CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*Response, error)
+	QueryTraffic(ctx context.Context, in *TrafficQuery, opts ...grpc.CallOption) (*TrafficReport, error)
+	ListRuleSets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RuleSetList, error)
+	UpdateRuleSets(ctx context.Context, in *RuleSetRequest, opts ...grpc.CallOption) (*RuleSetList, error)
+	RemoveRuleSets(ctx context.Context, in *RuleSetRequest, opts ...grpc.CallOption) (*Response, error)
+	ExplainRoute(ctx context.Context, in *RouteExplainRequest, opts ...grpc.CallOption) (*RouteExplainResponse, error)
+	CreateInstance(ctx context.Context, in *CreateInstanceRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	ListInstances(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*InstanceList, error)
+	InspectInstance(ctx context.Context, in *InstanceRequest, opts ...grpc.CallOption) (*InstanceResponse, error)
+	DestroyInstance(ctx context.Context, in *InstanceRequest, opts ...grpc.CallOption) (*Response, error)
+	InstanceLogs(ctx context.Context, in *InstanceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogMessage], error)
+	SpeedTest(ctx context.Context, in *SpeedTestRequest, opts ...grpc.CallOption) (*SpeedTestResponse, error)
+	ListWarpAccounts(ctx context.Context, in *ListWarpAccountsRequest, opts ...grpc.CallOption) (*WarpVaultList, error)
+	CreateWarpAccount(ctx context.Context, in *CreateWarpAccountRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error)
+	ImportWarpAccount(ctx context.Context, in *ImportWarpAccountRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error)
+	BindWarpLicense(ctx context.Context, in *BindWarpLicenseRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error)
+	RotateWarpAccount(ctx context.Context, in *WarpVaultRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error)
+	DeleteWarpAccount(ctx context.Context, in *DeleteWarpAccountRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type coreClient struct {
@@ -321,6 +377,16 @@ func (c *coreClient) ChangeHiddifySettings(ctx context.Context, in *ChangeHiddif
 	return out, nil
 }
 
+func (c *coreClient) ReloadHiddifySettings(ctx context.Context, in *ChangeHiddifySettingsRequest, opts ...grpc.CallOption) (*ReloadSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadSettingsResponse)
+	err := c.cc.Invoke(ctx, Core_ReloadHiddifySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) StartService(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*CoreInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CoreInfoResponse)
@@ -420,6 +486,303 @@ func (c *coreClient) LogListener(ctx context.Context, in *Empty, opts ...grpc.Ca
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Core_LogListenerClient = grpc.ServerStreamingClient[LogMessage]
 
+func (c *coreClient) AddProfile(ctx context.Context, in *AddProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, Core_AddProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProfileList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileList)
+	err := c.cc.Invoke(ctx, Core_ListProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RefreshProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, Core_RefreshProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ActivateProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, Core_ActivateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_DeleteProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetSubscriptionInfo(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SubscriptionInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionInfoResponse)
+	err := c.cc.Invoke(ctx, Core_GetSubscriptionInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, Core_Export_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) OutboundHealthInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OutboundHealthList], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[5], Core_OutboundHealthInfo_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, OutboundHealthList]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_OutboundHealthInfoClient = grpc.ServerStreamingClient[OutboundHealthList]
+
+func (c *coreClient) Connections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConnectionList], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[6], Core_Connections_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, ConnectionList]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_ConnectionsClient = grpc.ServerStreamingClient[ConnectionList]
+
+func (c *coreClient) CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_CloseConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) QueryTraffic(ctx context.Context, in *TrafficQuery, opts ...grpc.CallOption) (*TrafficReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrafficReport)
+	err := c.cc.Invoke(ctx, Core_QueryTraffic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListRuleSets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RuleSetList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleSetList)
+	err := c.cc.Invoke(ctx, Core_ListRuleSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateRuleSets(ctx context.Context, in *RuleSetRequest, opts ...grpc.CallOption) (*RuleSetList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleSetList)
+	err := c.cc.Invoke(ctx, Core_UpdateRuleSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RemoveRuleSets(ctx context.Context, in *RuleSetRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_RemoveRuleSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ExplainRoute(ctx context.Context, in *RouteExplainRequest, opts ...grpc.CallOption) (*RouteExplainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteExplainResponse)
+	err := c.cc.Invoke(ctx, Core_ExplainRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateInstance(ctx context.Context, in *CreateInstanceRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, Core_CreateInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListInstances(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*InstanceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceList)
+	err := c.cc.Invoke(ctx, Core_ListInstances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) InspectInstance(ctx context.Context, in *InstanceRequest, opts ...grpc.CallOption) (*InstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceResponse)
+	err := c.cc.Invoke(ctx, Core_InspectInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DestroyInstance(ctx context.Context, in *InstanceRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_DestroyInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) InstanceLogs(ctx context.Context, in *InstanceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[7], Core_InstanceLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[InstanceRequest, LogMessage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_InstanceLogsClient = grpc.ServerStreamingClient[LogMessage]
+
+func (c *coreClient) SpeedTest(ctx context.Context, in *SpeedTestRequest, opts ...grpc.CallOption) (*SpeedTestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpeedTestResponse)
+	err := c.cc.Invoke(ctx, Core_SpeedTest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListWarpAccounts(ctx context.Context, in *ListWarpAccountsRequest, opts ...grpc.CallOption) (*WarpVaultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpVaultList)
+	err := c.cc.Invoke(ctx, Core_ListWarpAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateWarpAccount(ctx context.Context, in *CreateWarpAccountRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpVaultResponse)
+	err := c.cc.Invoke(ctx, Core_CreateWarpAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ImportWarpAccount(ctx context.Context, in *ImportWarpAccountRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpVaultResponse)
+	err := c.cc.Invoke(ctx, Core_ImportWarpAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) BindWarpLicense(ctx context.Context, in *BindWarpLicenseRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpVaultResponse)
+	err := c.cc.Invoke(ctx, Core_BindWarpLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RotateWarpAccount(ctx context.Context, in *WarpVaultRequest, opts ...grpc.CallOption) (*WarpVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpVaultResponse)
+	err := c.cc.Invoke(ctx, Core_RotateWarpAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteWarpAccount(ctx context.Context, in *DeleteWarpAccountRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_DeleteWarpAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoreServer is the server API for Core service.
 // All implementations must embed UnimplementedCoreServer
 // for forward compatibility.
@@ -432,6 +795,7 @@ type CoreServer interface {
 	Setup(context.Context, *SetupRequest) (*Response, error)
 	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
 	ChangeHiddifySettings(context.Context, *ChangeHiddifySettingsRequest) (*CoreInfoResponse, error)
+	ReloadHiddifySettings(context.Context, *ChangeHiddifySettingsRequest) (*ReloadSettingsResponse, error)
 	//rpc GenerateConfig (GenerateConfigRequest) returns (GenerateConfigResponse);
 	StartService(context.Context, *StartRequest) (*CoreInfoResponse, error)
 	Stop(context.Context, *Empty) (*CoreInfoResponse, error)
@@ -442,6 +806,33 @@ type CoreServer interface {
 	GetSystemProxyStatus(context.Context, *Empty) (*SystemProxyStatus, error)
 	SetSystemProxyEnabled(context.Context, *SetSystemProxyEnabledRequest) (*Response, error)
 	LogListener(*Empty, grpc.ServerStreamingServer[LogMessage]) error
+	AddProfile(context.Context, *AddProfileRequest) (*ProfileResponse, error)
+	ListProfiles(context.Context, *Empty) (*ProfileList, error)
+	RefreshProfile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+	ActivateProfile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+	DeleteProfile(context.Context, *ProfileRequest) (*Response, error)
+	GetSubscriptionInfo(context.Context, *ProfileRequest) (*SubscriptionInfoResponse, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	OutboundHealthInfo(*Empty, grpc.ServerStreamingServer[OutboundHealthList]) error
+	Connections(*Empty, grpc.ServerStreamingServer[ConnectionList]) error
+	CloseConnection(context.Context, *CloseConnectionRequest) (*Response, error)
+	QueryTraffic(context.Context, *TrafficQuery) (*TrafficReport, error)
+	ListRuleSets(context.Context, *Empty) (*RuleSetList, error)
+	UpdateRuleSets(context.Context, *RuleSetRequest) (*RuleSetList, error)
+	RemoveRuleSets(context.Context, *RuleSetRequest) (*Response, error)
+	ExplainRoute(context.Context, *RouteExplainRequest) (*RouteExplainResponse, error)
+	CreateInstance(context.Context, *CreateInstanceRequest) (*InstanceResponse, error)
+	ListInstances(context.Context, *Empty) (*InstanceList, error)
+	InspectInstance(context.Context, *InstanceRequest) (*InstanceResponse, error)
+	DestroyInstance(context.Context, *InstanceRequest) (*Response, error)
+	InstanceLogs(*InstanceRequest, grpc.ServerStreamingServer[LogMessage]) error
+	SpeedTest(context.Context, *SpeedTestRequest) (*SpeedTestResponse, error)
+	ListWarpAccounts(context.Context, *ListWarpAccountsRequest) (*WarpVaultList, error)
+	CreateWarpAccount(context.Context, *CreateWarpAccountRequest) (*WarpVaultResponse, error)
+	ImportWarpAccount(context.Context, *ImportWarpAccountRequest) (*WarpVaultResponse, error)
+	BindWarpLicense(context.Context, *BindWarpLicenseRequest) (*WarpVaultResponse, error)
+	RotateWarpAccount(context.Context, *WarpVaultRequest) (*WarpVaultResponse, error)
+	DeleteWarpAccount(context.Context, *DeleteWarpAccountRequest) (*Response, error)
 	mustEmbedUnimplementedCoreServer()
 }
 
@@ -476,6 +867,9 @@ func (UnimplementedCoreServer) Parse(context.Context, *ParseRequest) (*ParseResp
 func (UnimplementedCoreServer) ChangeHiddifySettings(context.Context, *ChangeHiddifySettingsRequest) (*CoreInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeHiddifySettings not implemented")
 }
+func (UnimplementedCoreServer) ReloadHiddifySettings(context.Context, *ChangeHiddifySettingsRequest) (*ReloadSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadHiddifySettings not implemented")
+}
 func (UnimplementedCoreServer) StartService(context.Context, *StartRequest) (*CoreInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartService not implemented")
 }
@@ -503,6 +897,87 @@ func (UnimplementedCoreServer) SetSystemProxyEnabled(context.Context, *SetSystem
 func (UnimplementedCoreServer) LogListener(*Empty, grpc.ServerStreamingServer[LogMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method LogListener not implemented")
 }
+func (UnimplementedCoreServer) AddProfile(context.Context, *AddProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProfile not implemented")
+}
+func (UnimplementedCoreServer) ListProfiles(context.Context, *Empty) (*ProfileList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (UnimplementedCoreServer) RefreshProfile(context.Context, *ProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshProfile not implemented")
+}
+func (UnimplementedCoreServer) ActivateProfile(context.Context, *ProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateProfile not implemented")
+}
+func (UnimplementedCoreServer) DeleteProfile(context.Context, *ProfileRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedCoreServer) GetSubscriptionInfo(context.Context, *ProfileRequest) (*SubscriptionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionInfo not implemented")
+}
+func (UnimplementedCoreServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedCoreServer) OutboundHealthInfo(*Empty, grpc.ServerStreamingServer[OutboundHealthList]) error {
+	return status.Errorf(codes.Unimplemented, "method OutboundHealthInfo not implemented")
+}
+func (UnimplementedCoreServer) Connections(*Empty, grpc.ServerStreamingServer[ConnectionList]) error {
+	return status.Errorf(codes.Unimplemented, "method Connections not implemented")
+}
+func (UnimplementedCoreServer) CloseConnection(context.Context, *CloseConnectionRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseConnection not implemented")
+}
+func (UnimplementedCoreServer) QueryTraffic(context.Context, *TrafficQuery) (*TrafficReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTraffic not implemented")
+}
+func (UnimplementedCoreServer) ListRuleSets(context.Context, *Empty) (*RuleSetList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleSets not implemented")
+}
+func (UnimplementedCoreServer) UpdateRuleSets(context.Context, *RuleSetRequest) (*RuleSetList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuleSets not implemented")
+}
+func (UnimplementedCoreServer) RemoveRuleSets(context.Context, *RuleSetRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRuleSets not implemented")
+}
+func (UnimplementedCoreServer) ExplainRoute(context.Context, *RouteExplainRequest) (*RouteExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainRoute not implemented")
+}
+func (UnimplementedCoreServer) CreateInstance(context.Context, *CreateInstanceRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstance not implemented")
+}
+func (UnimplementedCoreServer) ListInstances(context.Context, *Empty) (*InstanceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedCoreServer) InspectInstance(context.Context, *InstanceRequest) (*InstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectInstance not implemented")
+}
+func (UnimplementedCoreServer) DestroyInstance(context.Context, *InstanceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyInstance not implemented")
+}
+func (UnimplementedCoreServer) InstanceLogs(*InstanceRequest, grpc.ServerStreamingServer[LogMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method InstanceLogs not implemented")
+}
+func (UnimplementedCoreServer) SpeedTest(context.Context, *SpeedTestRequest) (*SpeedTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpeedTest not implemented")
+}
+func (UnimplementedCoreServer) ListWarpAccounts(context.Context, *ListWarpAccountsRequest) (*WarpVaultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarpAccounts not implemented")
+}
+func (UnimplementedCoreServer) CreateWarpAccount(context.Context, *CreateWarpAccountRequest) (*WarpVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarpAccount not implemented")
+}
+func (UnimplementedCoreServer) ImportWarpAccount(context.Context, *ImportWarpAccountRequest) (*WarpVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWarpAccount not implemented")
+}
+func (UnimplementedCoreServer) BindWarpLicense(context.Context, *BindWarpLicenseRequest) (*WarpVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindWarpLicense not implemented")
+}
+func (UnimplementedCoreServer) RotateWarpAccount(context.Context, *WarpVaultRequest) (*WarpVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateWarpAccount not implemented")
+}
+func (UnimplementedCoreServer) DeleteWarpAccount(context.Context, *DeleteWarpAccountRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWarpAccount not implemented")
+}
 func (UnimplementedCoreServer) mustEmbedUnimplementedCoreServer() {}
 func (UnimplementedCoreServer) testEmbeddedByValue()              {}
 
@@ -640,8 +1115,26 @@ func _Core_ChangeHiddifySettings_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_StartService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartRequest)
+func _Core_ReloadHiddifySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeHiddifySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ReloadHiddifySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ReloadHiddifySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ReloadHiddifySettings(ctx, req.(*ChangeHiddifySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_StartService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -795,6 +1288,471 @@ func _Core_LogListener_Handler(srv interface{}, stream grpc.ServerStream) error
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Core_LogListenerServer = grpc.ServerStreamingServer[LogMessage]
 
+func _Core_AddProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).AddProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_AddProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).AddProfile(ctx, req.(*AddProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListProfiles(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RefreshProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RefreshProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RefreshProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RefreshProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ActivateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ActivateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ActivateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ActivateProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetSubscriptionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetSubscriptionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetSubscriptionInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetSubscriptionInfo(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_OutboundHealthInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).OutboundHealthInfo(m, &grpc.GenericServerStream[Empty, OutboundHealthList]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_OutboundHealthInfoServer = grpc.ServerStreamingServer[OutboundHealthList]
+
+func _Core_Connections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).Connections(m, &grpc.GenericServerStream[Empty, ConnectionList]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_ConnectionsServer = grpc.ServerStreamingServer[ConnectionList]
+
+func _Core_CloseConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CloseConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CloseConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CloseConnection(ctx, req.(*CloseConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_QueryTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).QueryTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_QueryTraffic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).QueryTraffic(ctx, req.(*TrafficQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListRuleSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListRuleSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListRuleSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListRuleSets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_UpdateRuleSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateRuleSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateRuleSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateRuleSets(ctx, req.(*RuleSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RemoveRuleSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RemoveRuleSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RemoveRuleSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RemoveRuleSets(ctx, req.(*RuleSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ExplainRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ExplainRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ExplainRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ExplainRoute(ctx, req.(*RouteExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_CreateInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateInstance(ctx, req.(*CreateInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListInstances(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_InspectInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).InspectInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_InspectInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).InspectInstance(ctx, req.(*InstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_DestroyInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DestroyInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DestroyInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DestroyInstance(ctx, req.(*InstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_InstanceLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InstanceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).InstanceLogs(m, &grpc.GenericServerStream[InstanceRequest, LogMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_InstanceLogsServer = grpc.ServerStreamingServer[LogMessage]
+
+func _Core_SpeedTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpeedTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).SpeedTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_SpeedTest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).SpeedTest(ctx, req.(*SpeedTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListWarpAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarpAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListWarpAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListWarpAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListWarpAccounts(ctx, req.(*ListWarpAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_CreateWarpAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateWarpAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateWarpAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateWarpAccount(ctx, req.(*CreateWarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ImportWarpAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ImportWarpAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ImportWarpAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ImportWarpAccount(ctx, req.(*ImportWarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_BindWarpLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindWarpLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).BindWarpLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_BindWarpLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).BindWarpLicense(ctx, req.(*BindWarpLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RotateWarpAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RotateWarpAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RotateWarpAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RotateWarpAccount(ctx, req.(*WarpVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_DeleteWarpAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteWarpAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteWarpAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteWarpAccount(ctx, req.(*DeleteWarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Core_ServiceDesc is the grpc.ServiceDesc for Core service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -818,6 +1776,10 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeHiddifySettings",
 			Handler:    _Core_ChangeHiddifySettings_Handler,
 		},
+		{
+			MethodName: "ReloadHiddifySettings",
+			Handler:    _Core_ReloadHiddifySettings_Handler,
+		},
 		{
 			MethodName: "StartService",
 			Handler:    _Core_StartService_Handler,
@@ -850,6 +1812,102 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSystemProxyEnabled",
 			Handler:    _Core_SetSystemProxyEnabled_Handler,
 		},
+		{
+			MethodName: "AddProfile",
+			Handler:    _Core_AddProfile_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _Core_ListProfiles_Handler,
+		},
+		{
+			MethodName: "RefreshProfile",
+			Handler:    _Core_RefreshProfile_Handler,
+		},
+		{
+			MethodName: "ActivateProfile",
+			Handler:    _Core_ActivateProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _Core_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionInfo",
+			Handler:    _Core_GetSubscriptionInfo_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _Core_Export_Handler,
+		},
+		{
+			MethodName: "CloseConnection",
+			Handler:    _Core_CloseConnection_Handler,
+		},
+		{
+			MethodName: "QueryTraffic",
+			Handler:    _Core_QueryTraffic_Handler,
+		},
+		{
+			MethodName: "ListRuleSets",
+			Handler:    _Core_ListRuleSets_Handler,
+		},
+		{
+			MethodName: "UpdateRuleSets",
+			Handler:    _Core_UpdateRuleSets_Handler,
+		},
+		{
+			MethodName: "RemoveRuleSets",
+			Handler:    _Core_RemoveRuleSets_Handler,
+		},
+		{
+			MethodName: "ExplainRoute",
+			Handler:    _Core_ExplainRoute_Handler,
+		},
+		{
+			MethodName: "CreateInstance",
+			Handler:    _Core_CreateInstance_Handler,
+		},
+		{
+			MethodName: "ListInstances",
+			Handler:    _Core_ListInstances_Handler,
+		},
+		{
+			MethodName: "InspectInstance",
+			Handler:    _Core_InspectInstance_Handler,
+		},
+		{
+			MethodName: "DestroyInstance",
+			Handler:    _Core_DestroyInstance_Handler,
+		},
+		{
+			MethodName: "SpeedTest",
+			Handler:    _Core_SpeedTest_Handler,
+		},
+		{
+			MethodName: "ListWarpAccounts",
+			Handler:    _Core_ListWarpAccounts_Handler,
+		},
+		{
+			MethodName: "CreateWarpAccount",
+			Handler:    _Core_CreateWarpAccount_Handler,
+		},
+		{
+			MethodName: "ImportWarpAccount",
+			Handler:    _Core_ImportWarpAccount_Handler,
+		},
+		{
+			MethodName: "BindWarpLicense",
+			Handler:    _Core_BindWarpLicense_Handler,
+		},
+		{
+			MethodName: "RotateWarpAccount",
+			Handler:    _Core_RotateWarpAccount_Handler,
+		},
+		{
+			MethodName: "DeleteWarpAccount",
+			Handler:    _Core_DeleteWarpAccount_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -877,6 +1935,21 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Core_LogListener_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "OutboundHealthInfo",
+			Handler:       _Core_OutboundHealthInfo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Connections",
+			Handler:       _Core_Connections_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InstanceLogs",
+			Handler:       _Core_InstanceLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "hiddify.proto",
 }
@@ -0,0 +1,207 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/hiddify/hiddify-core/config"
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/bufio"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+)
+
+var _ adapter.OutboundGroup = (*balancer)(nil)
+
+// balancer is the outbound of a load-balance group. The config builds the
+// group as a selector, which the controller replaces with a balancer in the
+// router before the box starts. The balancer picks a healthy member for
+// every connection and counts the traffic of each member.
+type balancer struct {
+	group      config.OutboundGroup
+	members    []string
+	router     adapter.Router
+	controller *groupController
+	// next is the round-robin position and last the member of the latest
+	// connection, both guarded by groupStatesAccess
+	next int
+	last string
+}
+
+// installBalancers replaces the selectors built for the load-balance groups
+// with balancers. It has to run before the box starts, so that the groups
+// containing a load-balance group get its balancer. Generated configs have
+// no inbound detours, the only thing the router keeps inbounds for.
+func (c *groupController) installBalancers(options option.Options) error {
+	if len(c.balancers) == 0 {
+		return nil
+	}
+	router, ok := c.router.(interface {
+		Initialize(inbounds []adapter.Inbound, outbounds []adapter.Outbound, defaultOutbound func() adapter.Outbound) error
+	})
+	if !ok {
+		return fmt.Errorf("load-balance groups are not supported by the router")
+	}
+	outbounds := make([]adapter.Outbound, 0, len(options.Outbounds))
+	for i, outboundOptions := range options.Outbounds {
+		tag := outboundOptions.Tag
+		if tag == "" {
+			tag = strconv.Itoa(i)
+		}
+		out, ok := c.router.Outbound(tag)
+		if !ok {
+			continue
+		}
+		if b, ok := c.balancer(tag); ok && outboundOptions.Type == C.TypeSelector {
+			b.members = outboundOptions.SelectorOptions.Outbounds
+			out = b
+		}
+		outbounds = append(outbounds, out)
+	}
+	return router.Initialize(nil, outbounds, func() adapter.Outbound {
+		out, _ := c.router.DefaultOutbound(N.NetworkTCP)
+		return out
+	})
+}
+
+func (b *balancer) Type() string {
+	return config.GroupTypeLoadBalance
+}
+
+func (b *balancer) Tag() string {
+	return b.group.Tag
+}
+
+func (b *balancer) Network() []string {
+	return []string{N.NetworkTCP, N.NetworkUDP}
+}
+
+func (b *balancer) Dependencies() []string {
+	return b.members
+}
+
+// Now is the member of the latest connection.
+func (b *balancer) Now() string {
+	groupStatesAccess.Lock()
+	defer groupStatesAccess.Unlock()
+	if b.last == "" && len(b.members) > 0 {
+		return b.members[0]
+	}
+	return b.last
+}
+
+func (b *balancer) All() []string {
+	return b.members
+}
+
+// pick returns the member for a new connection and its state, which holds
+// the traffic counters of the member.
+func (b *balancer) pick(metadata adapter.InboundContext) (adapter.Outbound, *groupMemberState, error) {
+	if len(b.members) == 0 {
+		return nil, nil, fmt.Errorf("group %s has no members", b.group.Tag)
+	}
+	groupStatesAccess.Lock()
+	state := b.controller.states[b.group.Tag]
+	healthy := state.healthyMembers(b.members, b.group.MaxFailures())
+	var tag string
+	switch b.group.BalanceStrategy() {
+	case config.BalanceRandom:
+		tag = healthy[rand.Intn(len(healthy))]
+	case config.BalanceConsistentHash:
+		domain, address := metadata.Domain, ""
+		if metadata.Destination.IsFqdn() && domain == "" {
+			domain = metadata.Destination.Fqdn
+		} else if metadata.Destination.IsIP() {
+			address = metadata.Destination.Addr.String()
+		}
+		tag = rendezvousMember(config.BalanceKey(domain, address), healthy)
+	default:
+		tag = healthy[b.next%len(healthy)]
+		b.next++
+	}
+	b.last = tag
+	member := state.member(tag)
+	groupStatesAccess.Unlock()
+
+	out, ok := b.router.Outbound(tag)
+	if !ok {
+		return nil, nil, fmt.Errorf("group %s: outbound %s not found", b.group.Tag, tag)
+	}
+	return out, member, nil
+}
+
+// dialMetadata is the metadata of the connection a dial is made for, or
+// just its destination for a dial of the core itself.
+func dialMetadata(ctx context.Context, network string, destination M.Socksaddr) adapter.InboundContext {
+	if metadata := adapter.ContextFrom(ctx); metadata != nil {
+		return *metadata
+	}
+	return adapter.InboundContext{Network: N.NetworkName(network), Destination: destination}
+}
+
+func (b *balancer) DialContext(ctx context.Context, network string, destination M.Socksaddr) (net.Conn, error) {
+	out, member, err := b.pick(dialMetadata(ctx, network, destination))
+	if err != nil {
+		return nil, err
+	}
+	conn, err := out.DialContext(ctx, network, destination)
+	if err != nil {
+		return nil, err
+	}
+	return bufio.NewInt64CounterConn(conn, []*atomic.Int64{&member.download}, []*atomic.Int64{&member.upload}), nil
+}
+
+func (b *balancer) ListenPacket(ctx context.Context, destination M.Socksaddr) (net.PacketConn, error) {
+	out, member, err := b.pick(dialMetadata(ctx, N.NetworkUDP, destination))
+	if err != nil {
+		return nil, err
+	}
+	conn, err := out.ListenPacket(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+	return &countedPacketConn{PacketConn: conn, member: member}, nil
+}
+
+func (b *balancer) NewConnection(ctx context.Context, conn net.Conn, metadata adapter.InboundContext) error {
+	out, member, err := b.pick(metadata)
+	if err != nil {
+		return err
+	}
+	conn = bufio.NewInt64CounterConn(conn, []*atomic.Int64{&member.upload}, []*atomic.Int64{&member.download})
+	return out.NewConnection(ctx, conn, metadata)
+}
+
+func (b *balancer) NewPacketConnection(ctx context.Context, conn N.PacketConn, metadata adapter.InboundContext) error {
+	out, member, err := b.pick(metadata)
+	if err != nil {
+		return err
+	}
+	conn = bufio.NewInt64CounterPacketConn(conn, []*atomic.Int64{&member.upload}, []*atomic.Int64{&member.download})
+	return out.NewPacketConnection(ctx, conn, metadata)
+}
+
+// countedPacketConn counts the traffic of a packet conn dialed through a
+// member.
+type countedPacketConn struct {
+	net.PacketConn
+	member *groupMemberState
+}
+
+func (c *countedPacketConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	n, addr, err := c.PacketConn.ReadFrom(p)
+	c.member.download.Add(int64(n))
+	return n, addr, err
+}
+
+func (c *countedPacketConn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	n, err := c.PacketConn.WriteTo(p, addr)
+	c.member.upload.Add(int64(n))
+	return n, err
+}
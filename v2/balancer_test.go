@@ -0,0 +1,153 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/hiddify/hiddify-core/config"
+	"github.com/sagernet/sing-box/adapter"
+	M "github.com/sagernet/sing/common/metadata"
+)
+
+type testRouter struct {
+	adapter.Router
+	outbounds map[string]adapter.Outbound
+	final     string
+}
+
+func (r *testRouter) Outbound(tag string) (adapter.Outbound, bool) {
+	out, ok := r.outbounds[tag]
+	return out, ok
+}
+
+func (r *testRouter) DefaultOutbound(network string) (adapter.Outbound, error) {
+	out, ok := r.outbounds[r.final]
+	if !ok {
+		return nil, fmt.Errorf("missing default outbound")
+	}
+	return out, nil
+}
+
+func (r *testRouter) Rules() []adapter.Rule {
+	return nil
+}
+
+func (r *testRouter) ClashServer() adapter.ClashServer {
+	return nil
+}
+
+// testOutbound counts its dials and answers them with the end of a pipe
+// that echoes.
+type testOutbound struct {
+	adapter.Outbound
+	tag    string
+	access sync.Mutex
+	dials  int
+}
+
+func (o *testOutbound) Tag() string {
+	return o.tag
+}
+
+func (o *testOutbound) DialContext(ctx context.Context, network string, destination M.Socksaddr) (net.Conn, error) {
+	o.access.Lock()
+	o.dials++
+	o.access.Unlock()
+	local, remote := net.Pipe()
+	go func() {
+		io.Copy(remote, remote)
+		remote.Close()
+	}()
+	return local, nil
+}
+
+func testBalancer(strategy string, members ...string) (*balancer, map[string]*testOutbound) {
+	router := &testRouter{outbounds: map[string]adapter.Outbound{}}
+	outbounds := map[string]*testOutbound{}
+	for _, member := range members {
+		outbounds[member] = &testOutbound{tag: member}
+		router.outbounds[member] = outbounds[member]
+	}
+	group := config.OutboundGroup{Tag: "balance", Type: config.GroupTypeLoadBalance, Strategy: strategy}
+	controller := newGroupController(router, &config.HiddifyOptions{Groups: []config.OutboundGroup{group}})
+	b := controller.balancers[0]
+	b.members = members
+	return b, outbounds
+}
+
+func TestBalancerRoundRobin(t *testing.T) {
+	b, outbounds := testBalancer(config.BalanceRoundRobin, "a", "b")
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conn, err := b.DialContext(context.Background(), "tcp", M.ParseSocksaddr("example.com:443"))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			conn.Close()
+		}()
+	}
+	wg.Wait()
+	// every dial gets its own pick, however many run at once
+	if outbounds["a"].dials != 50 || outbounds["b"].dials != 50 {
+		t.Errorf("uneven picks: a %d, b %d", outbounds["a"].dials, outbounds["b"].dials)
+	}
+}
+
+func TestBalancerSkipsFailingMembers(t *testing.T) {
+	b, outbounds := testBalancer(config.BalanceRoundRobin, "a", "b")
+	b.controller.states["balance"].member("a").failures = b.group.MaxFailures()
+	for range 4 {
+		conn, err := b.DialContext(context.Background(), "tcp", M.ParseSocksaddr("example.com:443"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+	}
+	if outbounds["a"].dials != 0 || outbounds["b"].dials != 4 {
+		t.Errorf("failing member was picked: a %d, b %d", outbounds["a"].dials, outbounds["b"].dials)
+	}
+	if b.Now() != "b" {
+		t.Errorf("unexpected latest member %s", b.Now())
+	}
+}
+
+func TestBalancerConsistentHash(t *testing.T) {
+	b, _ := testBalancer(config.BalanceConsistentHash, "a", "b", "c")
+	pick := func(domain string) string {
+		out, _, err := b.pick(adapter.InboundContext{Destination: M.ParseSocksaddr(domain + ":443")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out.Tag()
+	}
+	if first := pick("www.example.com"); pick("api.example.com") != first || pick("example.com") != first {
+		t.Error("subdomains of a site should share a member")
+	}
+}
+
+func TestBalancerCountsTraffic(t *testing.T) {
+	b, _ := testBalancer(config.BalanceRoundRobin, "a")
+	conn, err := b.DialContext(context.Background(), "tcp", M.ParseSocksaddr("example.com:443"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(conn, make([]byte, 4)); err != nil {
+		t.Fatal(err)
+	}
+	member := b.controller.states["balance"].member("a")
+	if member.upload.Load() != 4 || member.download.Load() != 4 {
+		t.Errorf("unexpected traffic: %d up, %d down", member.upload.Load(), member.download.Load())
+	}
+}
@@ -62,7 +62,9 @@ func (cch *CommandClientHandler) WriteGroups(message libbox.OutboundGroupIterato
 				},
 			)
 		}
-		groups.Items = append(groups.Items, &pb.OutboundGroup{Tag: group.Tag, Type: group.Type, Selected: group.Selected, Items: groupItems})
+		outboundGroup := &pb.OutboundGroup{Tag: group.Tag, Type: group.Type, Selected: group.Selected, Items: groupItems}
+		decorateGroup(outboundGroup)
+		groups.Items = append(groups.Items, outboundGroup)
 	}
 	outboundsInfoObserver.Emit(groups)
 	mainOutboundsInfoObserver.Emit(groups)
@@ -0,0 +1,193 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path/filepath"
+	"time"
+
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/experimental/clashapi/trafficontrol"
+	"google.golang.org/grpc"
+)
+
+const connectionsInterval = time.Second
+
+// trackerInfo is the json form of a clash api connection tracker.
+type trackerInfo struct {
+	Id       string `json:"id"`
+	Metadata struct {
+		Network         string `json:"network"`
+		Type            string `json:"type"`
+		SourceIP        string `json:"sourceIP"`
+		DestinationIP   string `json:"destinationIP"`
+		SourcePort      string `json:"sourcePort"`
+		DestinationPort string `json:"destinationPort"`
+		Host            string `json:"host"`
+		ProcessPath     string `json:"processPath"`
+	} `json:"metadata"`
+	Upload   int64     `json:"upload"`
+	Download int64     `json:"download"`
+	Start    time.Time `json:"start"`
+	Chains   []string  `json:"chains"`
+	Rule     string    `json:"rule"`
+	// User and RuleKey are not in the json; the routedServer that tracks
+	// the connection fills them in.
+	User    string `json:"-"`
+	RuleKey string `json:"-"`
+}
+
+func (t *trackerInfo) toPb() *pb.Connection {
+	conn := &pb.Connection{
+		Id:       t.Id,
+		Network:  t.Metadata.Network,
+		Inbound:  t.Metadata.Type,
+		Chain:    t.Chains,
+		Host:     t.Metadata.Host,
+		Rule:     t.Rule,
+		Start:    t.Start.Unix(),
+		Upload:   t.Upload,
+		Download: t.Download,
+	}
+	if t.Metadata.SourceIP != "" {
+		conn.Source = net.JoinHostPort(t.Metadata.SourceIP, t.Metadata.SourcePort)
+	}
+	if t.Metadata.DestinationIP != "" {
+		conn.Destination = net.JoinHostPort(t.Metadata.DestinationIP, t.Metadata.DestinationPort)
+	} else if t.Metadata.Host != "" {
+		conn.Destination = net.JoinHostPort(t.Metadata.Host, t.Metadata.DestinationPort)
+	}
+	if t.Metadata.ProcessPath != "" {
+		conn.Process = filepath.Base(t.Metadata.ProcessPath)
+	}
+	return conn
+}
+
+// routedServerOf returns the routedServer that tracks the connections of
+// router.
+func routedServerOf(router adapter.Router) (*routedServer, bool) {
+	server, ok := router.ClashServer().(*routedServer)
+	return server, ok && server != nil
+}
+
+func coreRoutedServer() (*routedServer, error) {
+	if Box == nil {
+		return nil, fmt.Errorf("instance not started")
+	}
+	server, ok := routedServerOf(Box.GetInstance().Router())
+	if !ok {
+		return nil, fmt.Errorf("connections are not tracked")
+	}
+	return server, nil
+}
+
+func snapshotConnections() ([]trackerInfo, error) {
+	server, err := coreRoutedServer()
+	if err != nil {
+		return nil, err
+	}
+	return server.connections(), nil
+}
+
+func managerConnections(manager *trafficontrol.Manager) []trackerInfo {
+	var infos []trackerInfo
+	for _, tracker := range manager.Snapshot().Connections {
+		if info, ok := trackerInfoOf(tracker); ok {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// trackerInfoOf reads a tracker through its clash api json.
+func trackerInfoOf(tracker any) (trackerInfo, bool) {
+	var info trackerInfo
+	content, err := json.Marshal(tracker)
+	if err != nil {
+		return info, false
+	}
+	return info, json.Unmarshal(content, &info) == nil
+}
+
+func listConnections() (*pb.ConnectionList, error) {
+	server, err := coreRoutedServer()
+	if err != nil {
+		return nil, err
+	}
+	return server.connectionList(), nil
+}
+
+func (s *routedServer) connectionList() *pb.ConnectionList {
+	infos := s.connections()
+	list := &pb.ConnectionList{}
+	for i := range infos {
+		list.Items = append(list.Items, infos[i].toPb())
+	}
+	return list
+}
+
+// closeConnections closes the connection id, or every connection with all,
+// and returns how many were closed.
+func (s *routedServer) closeConnections(id string, all bool) int {
+	closed := 0
+	for _, tracker := range s.traffic.Snapshot().Connections {
+		if all || tracker.ID() == id {
+			tracker.Close()
+			closed++
+		}
+	}
+	return closed
+}
+
+func (s *CoreService) Connections(req *pb.Empty, stream grpc.ServerStreamingServer[pb.ConnectionList]) error {
+	return streamConnections(stream, listConnections)
+}
+
+// streamConnections sends the list every connectionsInterval until the
+// stream ends.
+func streamConnections(stream grpc.ServerStreamingServer[pb.ConnectionList], list func() (*pb.ConnectionList, error)) error {
+	ticker := time.NewTicker(connectionsInterval)
+	defer ticker.Stop()
+	for {
+		connections, err := list()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(connections); err != nil {
+			return err
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *CoreService) CloseConnection(ctx context.Context, in *pb.CloseConnectionRequest) (*pb.Response, error) {
+	return CloseConnection(in)
+}
+
+func CloseConnection(in *pb.CloseConnectionRequest) (*pb.Response, error) {
+	server, err := coreRoutedServer()
+	if err != nil {
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	if closed := server.closeConnections(in.Id, in.All); closed == 0 && !in.All {
+		err := fmt.Errorf("connection %s not found", in.Id)
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
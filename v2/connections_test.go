@@ -0,0 +1,163 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/sagernet/sing-box/adapter"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+	"google.golang.org/grpc"
+)
+
+func testRoutedServer(t *testing.T, service *HiddifyService) *routedServer {
+	t.Helper()
+	server, ok := routedServerOf(service.libbox.GetInstance().Router())
+	if !ok {
+		t.Fatal("instance connections are not tracked")
+	}
+	return server
+}
+
+func TestTrackerInfo(t *testing.T) {
+	server := testRoutedServer(t, runTestInstance(t))
+	local, remote := net.Pipe()
+	defer remote.Close()
+	conn, tracker := server.RoutedConnection(context.Background(), local, adapter.InboundContext{
+		Inbound:     "mixed-in",
+		InboundType: "mixed",
+		Network:     N.NetworkTCP,
+		Source:      M.SocksaddrFrom(netip.MustParseAddr("192.168.1.20"), 50000),
+		Destination: M.SocksaddrFrom(netip.MustParseAddr("1.2.3.4"), 443),
+		Domain:      "example.com",
+		User:        "guest",
+	}, nil)
+	defer conn.Close()
+
+	infos := server.connections()
+	if len(infos) != 1 {
+		t.Fatalf("expected one connection, got %+v", infos)
+	}
+	info := infos[0]
+	if info.Metadata.Type != "mixed/mixed-in" || info.Metadata.Host != "example.com" || info.User != "guest" {
+		t.Errorf("unexpected metadata: %+v", info)
+	}
+	if len(info.Chains) == 0 || info.Chains[len(info.Chains)-1] != "out" {
+		t.Errorf("unexpected chain: %v", info.Chains)
+	}
+	connection := info.toPb()
+	if connection.Id == "" || connection.Source != "192.168.1.20:50000" || connection.Destination != "1.2.3.4:443" || connection.Inbound != "mixed/mixed-in" {
+		t.Errorf("unexpected connection: %v", connection)
+	}
+
+	tracker.Leave()
+	if infos := server.connections(); len(infos) != 0 {
+		t.Errorf("left connection is still listed: %+v", infos)
+	}
+}
+
+func TestInstanceConnections(t *testing.T) {
+	service := runTestInstance(t)
+	server := testRoutedServer(t, service)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+
+	conn, err := service.DialContext(ctx, "tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.ReadFull(conn, make([]byte, 4)); err != nil {
+		t.Fatal(err)
+	}
+
+	var id string
+	for _, connection := range server.connectionList().Items {
+		if connection.Destination == listener.Addr().String() {
+			id = connection.Id
+		}
+	}
+	if id == "" {
+		t.Fatalf("connection to %s is not listed: %v", listener.Addr(), server.connectionList().Items)
+	}
+	if closed := server.closeConnections("missing", false); closed != 0 {
+		t.Errorf("closed %d connections for a missing id", closed)
+	}
+	if closed := server.closeConnections(id, false); closed != 1 {
+		t.Fatalf("expected one closed connection, got %d", closed)
+	}
+	if _, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Error("closed connection is still readable")
+	}
+}
+
+type testConnectionStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.ConnectionList
+}
+
+func (s *testConnectionStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testConnectionStream) Send(list *pb.ConnectionList) error {
+	s.sent <- list
+	return nil
+}
+
+func TestStreamConnections(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testConnectionStream{ctx: ctx, sent: make(chan *pb.ConnectionList, 8)}
+	list := &pb.ConnectionList{Items: []*pb.Connection{{Id: "a"}}}
+	done := make(chan error, 1)
+	go func() {
+		done <- streamConnections(stream, func() (*pb.ConnectionList, error) { return list, nil })
+	}()
+	if sent := <-stream.sent; len(sent.Items) != 1 || sent.Items[0].Id != "a" {
+		t.Errorf("unexpected list: %v", sent.Items)
+	}
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("a closed stream should end without error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end")
+	}
+
+	failure := errors.New("not started")
+	err := streamConnections(&testConnectionStream{ctx: context.Background()}, func() (*pb.ConnectionList, error) { return nil, failure })
+	if !errors.Is(err, failure) {
+		t.Errorf("expected the list error, got %v", err)
+	}
+}
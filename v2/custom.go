@@ -13,14 +13,20 @@ import (
 	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
 	"github.com/sagernet/sing-box/experimental/libbox"
 	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
 )
 
 var (
-	Box              *libbox.BoxService
-	HiddifyOptions   *config.HiddifyOptions
-	activeConfigPath string
-	coreLogFactory   log.Factory
-	useFlutterBridge bool = true
+	Box                *libbox.BoxService
+	HiddifyOptions     *config.HiddifyOptions
+	activeConfigPath   string
+	activeStartRequest *pb.StartRequest
+	// activeConfigContent is the config the core was started with and
+	// activeBuiltConfig what was built from it, nil for a raw config
+	activeConfigContent string
+	activeBuiltConfig   *option.Options
+	coreLogFactory     log.Factory
+	useFlutterBridge   bool = true
 )
 
 func StopAndAlert(msgType pb.MessageType, message string) {
@@ -33,6 +39,7 @@ func StopAndAlert(msgType pb.MessageType, message string) {
 		Box.Close()
 		Box = nil
 	}
+	stopFirewall()
 	if oldCommandServer != nil {
 		oldCommandServer.Close()
 	}
@@ -74,7 +81,18 @@ func (s *CoreService) StartService(ctx context.Context, in *pb.StartRequest) (*p
 
 func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 	Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Starting Core Service")
+	activeStartRequest = in
 	content := in.ConfigContent
+	if content == "" && in.ConfigPath == "" {
+		profileContent, err := activeProfileConfig()
+		if err != nil {
+			Log(pb.LogLevel_FATAL, pb.LogType_CORE, err.Error())
+			resp := SetCoreStatus(pb.CoreState_STOPPED, pb.MessageType_ERROR_READING_CONFIG, err.Error())
+			StopAndAlert(pb.MessageType_UNEXPECTED_ERROR, err.Error())
+			return resp, err
+		}
+		content = profileContent
+	}
 	if content == "" {
 
 		activeConfigPath = in.ConfigPath
@@ -88,6 +106,7 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 		content = string(fileContent)
 	}
 	Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Parsing Config")
+	activeConfigContent = content
 
 	parsedContent, err := readOptions(content)
 	Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Parsed")
@@ -98,9 +117,13 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 		StopAndAlert(pb.MessageType_UNEXPECTED_ERROR, err.Error())
 		return resp, err
 	}
+	routeRuleOrigins = nil
+	activeBuiltConfig = nil
 	if !in.EnableRawConfig {
 		Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Building config")
-		parsedContent_tmp, err := config.BuildConfig(*HiddifyOptions, parsedContent)
+		buildOptions := *HiddifyOptions
+		ruleSets.prepare(&buildOptions)
+		parsedContent_tmp, origins, err := config.BuildConfigWithRuleOrigins(buildOptions, parsedContent)
 		if err != nil {
 			Log(pb.LogLevel_FATAL, pb.LogType_CORE, err.Error())
 			resp := SetCoreStatus(pb.CoreState_STOPPED, pb.MessageType_ERROR_BUILDING_CONFIG, err.Error())
@@ -108,6 +131,8 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 			return resp, err
 		}
 		parsedContent = *parsedContent_tmp
+		routeRuleOrigins = origins
+		activeBuiltConfig = parsedContent_tmp
 	}
 	Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Saving config")
 	currentBuildConfigPath := filepath.Join(sWorkingPath, "current-config.json")
@@ -146,6 +171,13 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 		StopAndAlert(pb.MessageType_UNEXPECTED_ERROR, err.Error())
 		return resp, err
 	}
+	if err := startFirewall(HiddifyOptions); err != nil {
+		instance.Close()
+		Log(pb.LogLevel_FATAL, pb.LogType_CORE, err.Error())
+		resp := SetCoreStatus(pb.CoreState_STOPPED, pb.MessageType_START_SERVICE, err.Error())
+		StopAndAlert(pb.MessageType_UNEXPECTED_ERROR, err.Error())
+		return resp, err
+	}
 	Box = instance
 	if in.EnableOldCommandServer {
 		oldCommandServer.SetService(Box)
@@ -176,15 +208,25 @@ func Parse(in *pb.ParseRequest) (*pb.ParseResponse, error) {
 
 	}
 
-	config, err := config.ParseConfigContent(content, true, HiddifyOptions, false)
+	info := config.ParseSubscriptionInfo(nil, content)
+	var parsed []byte
+	var report *config.ParseReport
+	var err error
+	if in.Lenient {
+		parsed, report, err = config.ParseConfigContentLenient(content, true, HiddifyOptions, false)
+	} else {
+		parsed, err = config.ParseConfigContent(content, true, HiddifyOptions, false)
+	}
 	if err != nil {
 		return &pb.ParseResponse{
 			ResponseCode: pb.ResponseCode_FAILED,
 			Message:      err.Error(),
+			ParseErrors:  parseAttemptsToPb(err),
+			Skipped:      skippedEntriesToPb(report),
 		}, err
 	}
 	if in.ConfigPath != "" {
-		err = os.WriteFile(in.ConfigPath, config, 0o644)
+		err = os.WriteFile(in.ConfigPath, parsed, 0o644)
 		if err != nil {
 			return &pb.ParseResponse{
 				ResponseCode: pb.ResponseCode_FAILED,
@@ -193,9 +235,11 @@ func Parse(in *pb.ParseRequest) (*pb.ParseResponse, error) {
 		}
 	}
 	return &pb.ParseResponse{
-		ResponseCode: pb.ResponseCode_OK,
-		Content:      string(config),
-		Message:      "",
+		ResponseCode:     pb.ResponseCode_OK,
+		Content:          string(parsed),
+		Message:          "",
+		SubscriptionInfo: subscriptionInfoToPb(info),
+		Skipped:          skippedEntriesToPb(report),
 	}, err
 }
 
@@ -204,24 +248,33 @@ func (s *CoreService) ChangeHiddifySettings(ctx context.Context, in *pb.ChangeHi
 }
 
 func ChangeHiddifySettings(in *pb.ChangeHiddifySettingsRequest) (*pb.CoreInfoResponse, error) {
-	HiddifyOptions = config.DefaultHiddifyOptions()
-	err := json.Unmarshal([]byte(in.HiddifySettingsJson), HiddifyOptions)
+	options, err := parseHiddifySettings(in.HiddifySettingsJson)
+	if err != nil {
+		return nil, err
+	}
+	HiddifyOptions = options
+	return &pb.CoreInfoResponse{}, nil
+}
+
+func parseHiddifySettings(settingsJson string) (*config.HiddifyOptions, error) {
+	options := config.DefaultHiddifyOptions()
+	err := json.Unmarshal([]byte(settingsJson), options)
 	if err != nil {
 		return nil, err
 	}
-	if HiddifyOptions.Warp.WireguardConfigStr != "" {
-		err := json.Unmarshal([]byte(HiddifyOptions.Warp.WireguardConfigStr), &HiddifyOptions.Warp.WireguardConfig)
+	if options.Warp.WireguardConfigStr != "" {
+		err := json.Unmarshal([]byte(options.Warp.WireguardConfigStr), &options.Warp.WireguardConfig)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if HiddifyOptions.Warp2.WireguardConfigStr != "" {
-		err := json.Unmarshal([]byte(HiddifyOptions.Warp2.WireguardConfigStr), &HiddifyOptions.Warp2.WireguardConfig)
+	if options.Warp2.WireguardConfigStr != "" {
+		err := json.Unmarshal([]byte(options.Warp2.WireguardConfigStr), &options.Warp2.WireguardConfig)
 		if err != nil {
 			return nil, err
 		}
 	}
-	return &pb.CoreInfoResponse{}, nil
+	return options, nil
 }
 
 func (s *CoreService) GenerateConfig(ctx context.Context, in *pb.GenerateConfigRequest) (*pb.GenerateConfigResponse, error) {
@@ -302,6 +355,7 @@ func Stop() (*pb.CoreInfoResponse, error) {
 		}, fmt.Errorf("Error while stopping the service.")
 	}
 	Box = nil
+	stopFirewall()
 	if oldCommandServer != nil {
 		err = oldCommandServer.Close()
 		if err != nil {
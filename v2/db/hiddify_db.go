@@ -3,6 +3,7 @@ package db
 import (
 	"bytes"
 	"encoding/gob"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -13,6 +14,9 @@ import (
 	tmdb "github.com/tendermint/tm-db"
 )
 
+// ErrNotFound is returned by Get when no record has the given id.
+var ErrNotFound = errors.New("not found")
+
 // getDB initializes the database with retry logic. If it fails after 100 attempts, it returns nil.
 func getDB(name string, readOnly bool) (tmdb.DB, error) {
 	// Check if the database file exists; if not, set to readOnly
@@ -188,7 +192,7 @@ func (tbl *Table[T]) UpdateInsert(items ...*T) error {
 
 // Delete removes entries by their IDs.
 func (tbl *Table[T]) Delete(ids ...any) error {
-	db, err := getDB(tbl.name, true)
+	db, err := getDB(tbl.name, false)
 	if db == nil {
 		return fmt.Errorf("failed to open database %s, error: %w", tbl.name, err)
 	}
@@ -214,5 +218,8 @@ func (tbl *Table[T]) Get(id any) (*T, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(b) == 0 {
+		return nil, ErrNotFound
+	}
 	return Deserialize[T](b)
 }
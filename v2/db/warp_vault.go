@@ -0,0 +1,30 @@
+package db
+
+import "time"
+
+// WarpVaultEntry is a warp account kept in the vault. Outbounds reference it
+// by Id, so its keys can be rotated or its license changed without editing
+// the configs that use it.
+type WarpVaultEntry struct {
+	Id          string
+	Name        string
+	DeviceID    string
+	AccessToken string
+	License     string
+	AccountType string
+	WarpPlus    bool
+
+	PrivateKey       string
+	PeerPublicKey    string
+	LocalAddressIPv4 string
+	LocalAddressIPv6 string
+	ClientID         string
+
+	CreatedAt time.Time
+	RotatedAt time.Time
+}
+
+// WarpVault returns the table of the warp account vault.
+func WarpVault() *Table[WarpVaultEntry] {
+	return GetTable[WarpVaultEntry]()
+}
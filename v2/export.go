@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"context"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+)
+
+var exportFormats = map[pb.ExportFormat]config.ExportFormat{
+	pb.ExportFormat_SHARE_LINK: config.ExportFormatShareLink,
+	pb.ExportFormat_CLASH:      config.ExportFormatClash,
+	pb.ExportFormat_SINGBOX:    config.ExportFormatSingbox,
+}
+
+func (s *CoreService) Export(ctx context.Context, in *pb.ExportRequest) (*pb.ExportResponse, error) {
+	return Export(in)
+}
+
+func Export(in *pb.ExportRequest) (*pb.ExportResponse, error) {
+	content := in.Content
+	if content == "" {
+		var profile *Profile
+		var err error
+		if in.ProfileId == "" {
+			profile, err = ActiveProfile()
+		} else {
+			profile, err = getProfile(in.ProfileId)
+		}
+		if err != nil {
+			return exportFailed(err)
+		}
+		content = profile.Content
+	}
+	result, err := config.ExportConfigContent(content, exportFormats[in.Format], in.Tags)
+	if err != nil {
+		return exportFailed(err)
+	}
+	return &pb.ExportResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Content:      result.Content,
+		Skipped:      result.Skipped,
+	}, nil
+}
+
+func exportFailed(err error) (*pb.ExportResponse, error) {
+	return &pb.ExportResponse{
+		ResponseCode: pb.ResponseCode_FAILED,
+		Message:      err.Error(),
+	}, err
+}
@@ -0,0 +1,112 @@
+package v2
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+)
+
+// detectFirewall prefers nftables and falls back to iptables.
+func detectFirewall() (string, error) {
+	if _, err := exec.LookPath("nft"); err == nil {
+		return config.FirewallNFTables, nil
+	}
+	if _, err := exec.LookPath("iptables"); err == nil {
+		return config.FirewallIPTables, nil
+	}
+	return "", fmt.Errorf("neither nft nor iptables found")
+}
+
+func runFirewallCommand(command config.FirewallCommand) error {
+	cmd := exec.Command(command.Args[0], command.Args[1:]...)
+	if command.Stdin != "" {
+		cmd.Stdin = strings.NewReader(command.Stdin)
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %w: %s", command, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// applyTransparentProxyFirewall applies the firewall rules of the
+// transparent proxy and returns the function that reverts them. Nothing is
+// applied when the transparent proxy or the firewall is disabled.
+func applyTransparentProxyFirewall(opt *config.HiddifyOptions) (func(), error) {
+	noop := func() {}
+	if opt.TransparentProxy == "" || opt.TransparentProxyFirewall == config.FirewallNone {
+		return noop, nil
+	}
+	if runtime.GOOS != "linux" {
+		return noop, fmt.Errorf("transparent proxy is only supported on linux")
+	}
+	firewall := opt.TransparentProxyFirewall
+	if firewall == "" || firewall == config.FirewallAuto {
+		detected, err := detectFirewall()
+		if err != nil {
+			return noop, err
+		}
+		firewall = detected
+	}
+	rules, err := config.MakeFirewallRules(opt, firewall)
+	if err != nil {
+		return noop, err
+	}
+	revert := func() {
+		for _, command := range rules.Revert {
+			// rules of a failed apply may be missing
+			if err := runFirewallCommand(command); err != nil {
+				Log(pb.LogLevel_DEBUG, pb.LogType_CORE, err.Error())
+			}
+		}
+	}
+	// leftovers of a run that was killed would make the apply fail
+	revert()
+	for _, command := range rules.Apply {
+		if err := runFirewallCommand(command); err != nil {
+			revert()
+			return noop, err
+		}
+	}
+	Log(pb.LogLevel_INFO, pb.LogType_CORE, fmt.Sprintf("%s rules for the %s transparent proxy applied", firewall, opt.TransparentProxy))
+	return revert, nil
+}
+
+var (
+	firewallAccess sync.Mutex
+	// revertFirewall reverts the rules applied for the running core.
+	revertFirewall = func() {}
+)
+
+// startFirewall applies the firewall rules of opt for the core that was just
+// started, replacing the rules of a previous start.
+func startFirewall(opt *config.HiddifyOptions) error {
+	firewallAccess.Lock()
+	defer firewallAccess.Unlock()
+	revertFirewallLocked()
+	if opt == nil {
+		return nil
+	}
+	revert, err := applyTransparentProxyFirewall(opt)
+	if err != nil {
+		return err
+	}
+	revertFirewall = revert
+	return nil
+}
+
+func stopFirewall() {
+	firewallAccess.Lock()
+	defer firewallAccess.Unlock()
+	revertFirewallLocked()
+}
+
+func revertFirewallLocked() {
+	revertFirewall()
+	revertFirewall = func() {}
+}
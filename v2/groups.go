@@ -0,0 +1,386 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/common/urltest"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/outbound"
+)
+
+// groupStates are the states of the groups of the main core and coreGroups
+// its controller, published by it. Instances keep theirs to themselves.
+var (
+	groupStatesAccess sync.Mutex
+	groupStates       = map[string]*groupState{}
+	coreGroups        *groupController
+)
+
+type groupMemberState struct {
+	failures     int
+	healthySince time.Time
+	lastError    string
+	// upload and download are the traffic a load-balance group sent through
+	// the member; its balancer adds to them without the lock
+	upload   atomic.Int64
+	download atomic.Int64
+}
+
+// groupState is what the controller knows about a managed group; it is
+// merged into the OutboundGroupList stream.
+type groupState struct {
+	groupType string
+	reason    string
+	members   map[string]*groupMemberState
+}
+
+func (s *groupState) member(tag string) *groupMemberState {
+	member, ok := s.members[tag]
+	if !ok {
+		member = &groupMemberState{}
+		s.members[tag] = member
+	}
+	return member
+}
+
+// decorateGroup adds the controller state to a group of the stream.
+func decorateGroup(group *pb.OutboundGroup) {
+	groupStatesAccess.Lock()
+	defer groupStatesAccess.Unlock()
+	state, ok := groupStates[group.Tag]
+	if !ok {
+		return
+	}
+	group.Type = state.groupType
+	group.Reason = state.reason
+	for _, item := range group.Items {
+		if member, ok := state.members[item.Tag]; ok {
+			item.Failures = int32(member.failures)
+			item.LastError = member.lastError
+			item.Upload = member.upload.Load()
+			item.Download = member.download.Load()
+		}
+	}
+}
+
+// groupController drives fallback and load-balance groups. A fallback group
+// is a selector that stays on its first healthy member. A load-balance group
+// is a balancer that picks a healthy member for every connection.
+type groupController struct {
+	router    adapter.Router
+	fallbacks []config.OutboundGroup
+	balancers []*balancer
+	link      string
+	// states are guarded by groupStatesAccess
+	states map[string]*groupState
+	// builtURLTestInterval is the interval the url-test groups were built
+	// with and urlTestInterval the one of the current settings, guarded by
+	// groupStatesAccess; see runURLTests
+	builtURLTestInterval   time.Duration
+	urlTestInterval        time.Duration
+	urlTestIntervalChanged chan struct{}
+	// log receives the group switches, Log by default
+	log func(level pb.LogLevel, typ pb.LogType, message string)
+}
+
+func newGroupController(router adapter.Router, opt *config.HiddifyOptions) *groupController {
+	controller := &groupController{
+		router:                 router,
+		link:                   healthDefaultURL,
+		states:                 map[string]*groupState{},
+		builtURLTestInterval:   opt.URLTestInterval.Duration(),
+		urlTestInterval:        opt.URLTestInterval.Duration(),
+		urlTestIntervalChanged: make(chan struct{}, 1),
+		log:                    Log,
+	}
+	if opt.ConnectionTestUrl != "" {
+		controller.link = opt.ConnectionTestUrl
+	}
+	for _, group := range config.ManagedGroups(opt) {
+		controller.states[group.Tag] = &groupState{groupType: group.Type, members: map[string]*groupMemberState{}}
+		switch group.Type {
+		case config.GroupTypeFallback:
+			controller.fallbacks = append(controller.fallbacks, group)
+		case config.GroupTypeLoadBalance:
+			controller.balancers = append(controller.balancers, &balancer{group: group, router: router, controller: controller})
+		}
+	}
+	return controller
+}
+
+// publish makes the states of c the ones merged into the OutboundGroupList
+// stream.
+func (c *groupController) publish() {
+	groupStatesAccess.Lock()
+	defer groupStatesAccess.Unlock()
+	groupStates = c.states
+	coreGroups = c
+}
+
+func (c *groupController) run(ctx context.Context) {
+	for _, group := range c.fallbacks {
+		go c.runChecks(ctx, group, c.checkFallback)
+	}
+	for _, b := range c.balancers {
+		go c.runChecks(ctx, b.group, c.checkBalancer)
+	}
+	go c.runURLTests(ctx)
+}
+
+// setURLTestInterval changes how often the url-test groups are tested.
+func (c *groupController) setURLTestInterval(interval time.Duration) {
+	groupStatesAccess.Lock()
+	c.urlTestInterval = interval
+	groupStatesAccess.Unlock()
+	select {
+	case c.urlTestIntervalChanged <- struct{}{}:
+	default:
+	}
+}
+
+// runURLTests tests the url-test groups at the interval of the current
+// settings once it differs from the one they were built with. The groups
+// keep their own checks too, so a longer interval only takes full effect
+// from the next start.
+func (c *groupController) runURLTests(ctx context.Context) {
+	for {
+		groupStatesAccess.Lock()
+		interval, changed := c.urlTestInterval, c.urlTestInterval != c.builtURLTestInterval
+		groupStatesAccess.Unlock()
+		if interval <= 0 {
+			interval = C.DefaultURLTestInterval
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-c.urlTestIntervalChanged:
+			timer.Stop()
+			continue
+		case <-timer.C:
+		}
+		if !changed {
+			continue
+		}
+		for _, out := range c.router.Outbounds() {
+			if group, ok := out.(interface{ CheckOutbounds() }); ok && out.Type() == C.TypeURLTest {
+				go group.CheckOutbounds()
+			}
+		}
+	}
+}
+
+func (c *groupController) selector(tag string) (*outbound.Selector, bool) {
+	out, ok := c.router.Outbound(tag)
+	if !ok {
+		return nil, false
+	}
+	selector, ok := out.(*outbound.Selector)
+	return selector, ok
+}
+
+func (c *groupController) balancer(tag string) (*balancer, bool) {
+	for _, b := range c.balancers {
+		if b.group.Tag == tag {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+func (c *groupController) runChecks(ctx context.Context, group config.OutboundGroup, check func(context.Context, config.OutboundGroup)) {
+	ticker := time.NewTicker(group.CheckInterval())
+	defer ticker.Stop()
+	for {
+		check(ctx, group)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateHealth tests every member of a group and records the results.
+func (c *groupController) updateHealth(ctx context.Context, tag string, members []string) {
+	results := c.test(ctx, members)
+	now := time.Now()
+	groupStatesAccess.Lock()
+	defer groupStatesAccess.Unlock()
+	state := c.states[tag]
+	for _, member := range members {
+		memberState := state.member(member)
+		if err := results[member]; err != nil {
+			memberState.failures++
+			memberState.healthySince = time.Time{}
+			memberState.lastError = err.Error()
+		} else {
+			if memberState.healthySince.IsZero() {
+				memberState.healthySince = now
+			}
+			memberState.failures = 0
+		}
+	}
+}
+
+// checkFallback tests every member of a fallback group and selects the first
+// healthy one. A member is healthy until it fails FailureThreshold checks in
+// a row, and a member before the selected one is only returned to after it
+// has passed every check for RecoveryDelay.
+func (c *groupController) checkFallback(ctx context.Context, group config.OutboundGroup) {
+	selector, ok := c.selector(group.Tag)
+	if !ok {
+		return
+	}
+	members := selector.All()
+	c.updateHealth(ctx, group.Tag, members)
+	now := time.Now()
+
+	groupStatesAccess.Lock()
+	state := c.states[group.Tag]
+	current := selector.Now()
+	target, reason := fallbackTarget(members, current, state, group, now)
+	state.reason = reason
+	groupStatesAccess.Unlock()
+
+	if target != current && selector.SelectOutbound(target) {
+		c.log(pb.LogLevel_INFO, pb.LogType_CORE, fmt.Sprintf("group %s switched from %s to %s: %s", group.Tag, current, target, reason))
+	}
+}
+
+func fallbackTarget(members []string, current string, state *groupState, group config.OutboundGroup, now time.Time) (string, string) {
+	healthy := func(tag string) bool {
+		return state.member(tag).failures < group.MaxFailures()
+	}
+	currentIndex := len(members)
+	for i, member := range members {
+		if member == current {
+			currentIndex = i
+		}
+	}
+	// waiting is why an earlier member that still counts as healthy is not
+	// returned to yet; it has to pass every check for RecoveryDelay first.
+	var waiting string
+	for i, member := range members {
+		if !healthy(member) {
+			continue
+		}
+		switch {
+		case i == currentIndex:
+			if waiting != "" {
+				return member, waiting
+			}
+			if i == 0 {
+				return member, "first member is healthy"
+			}
+			return member, fmt.Sprintf("%s is the first healthy member", member)
+		case i < currentIndex && currentIndex < len(members) && healthy(current):
+			memberState := state.member(member)
+			if memberState.failures > 0 || memberState.healthySince.IsZero() {
+				if waiting == "" {
+					waiting = fmt.Sprintf("%s failed its last check, staying on %s", member, current)
+				}
+				continue
+			}
+			if wait := group.RecoveryDuration() - now.Sub(memberState.healthySince); wait > 0 {
+				if waiting == "" {
+					waiting = fmt.Sprintf("%s recovered, returning to it in %s", member, wait.Round(time.Second))
+				}
+				continue
+			}
+			return member, fmt.Sprintf("%s has been healthy for %s", member, group.RecoveryDuration())
+		default:
+			if currentIndex < len(members) {
+				failed := state.member(current)
+				return member, fmt.Sprintf("%s failed %d checks: %s", current, failed.failures, failed.lastError)
+			}
+			return member, fmt.Sprintf("%s is the first healthy member", member)
+		}
+	}
+	return current, "all members are failing"
+}
+
+// test runs a url test on each member and returns the error of each one.
+func (c *groupController) test(ctx context.Context, members []string) map[string]error {
+	results := make(map[string]error, len(members))
+	var access sync.Mutex
+	var wg sync.WaitGroup
+	for _, member := range members {
+		detour, ok := c.router.Outbound(member)
+		if !ok {
+			access.Lock()
+			results[member] = fmt.Errorf("outbound not found")
+			access.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(member string, detour adapter.Outbound) {
+			defer wg.Done()
+			testCtx, cancel := context.WithTimeout(ctx, healthProbeTimeout)
+			defer cancel()
+			_, err := urltest.URLTest(testCtx, c.link, detour)
+			access.Lock()
+			results[member] = err
+			access.Unlock()
+		}(member, detour)
+	}
+	wg.Wait()
+	return results
+}
+
+// healthyMembers returns the members that are not failing, or all of them
+// when every member is.
+func (s *groupState) healthyMembers(members []string, maxFailures int) []string {
+	var healthy []string
+	for _, member := range members {
+		if s.member(member).failures < maxFailures {
+			healthy = append(healthy, member)
+		}
+	}
+	if len(healthy) == 0 {
+		return members
+	}
+	return healthy
+}
+
+// checkBalancer updates the health of the members of a load-balance group,
+// which its balancer picks from.
+func (c *groupController) checkBalancer(ctx context.Context, group config.OutboundGroup) {
+	b, ok := c.balancer(group.Tag)
+	if !ok || len(b.members) == 0 {
+		return
+	}
+	c.updateHealth(ctx, group.Tag, b.members)
+
+	groupStatesAccess.Lock()
+	defer groupStatesAccess.Unlock()
+	state := c.states[group.Tag]
+	healthy := state.healthyMembers(b.members, group.MaxFailures())
+	state.reason = fmt.Sprintf("%s over %d of %d members", group.BalanceStrategy(), len(healthy), len(b.members))
+}
+
+// rendezvousMember picks the member with the highest hash for key, so that
+// losing a member only moves the keys that used it.
+func rendezvousMember(key string, members []string) string {
+	var best string
+	var bestHash uint64
+	for _, member := range members {
+		hash := fnv.New64a()
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(member))
+		if sum := hash.Sum64(); best == "" || sum > bestHash {
+			best, bestHash = member, sum
+		}
+	}
+	return best
+}
@@ -0,0 +1,97 @@
+package v2
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+)
+
+func TestFallbackTarget(t *testing.T) {
+	now := time.Now()
+	group := config.OutboundGroup{Tag: "fallback", Type: config.GroupTypeFallback}
+	healthy := func(since time.Duration) *groupMemberState {
+		return &groupMemberState{healthySince: now.Add(-since)}
+	}
+	failing := func(failures int) *groupMemberState {
+		return &groupMemberState{failures: failures, lastError: "timeout"}
+	}
+	tests := []struct {
+		name    string
+		current string
+		members map[string]*groupMemberState
+		target  string
+		reason  string
+	}{
+		{
+			name:    "first healthy",
+			current: "a",
+			members: map[string]*groupMemberState{"a": healthy(time.Hour), "b": healthy(time.Hour)},
+			target:  "a",
+			reason:  "first member is healthy",
+		},
+		{
+			name:    "not selected yet",
+			current: "",
+			members: map[string]*groupMemberState{"a": failing(2), "b": healthy(time.Hour)},
+			target:  "b",
+			reason:  "b is the first healthy member",
+		},
+		{
+			name:    "fail",
+			current: "a",
+			members: map[string]*groupMemberState{"a": failing(2), "b": healthy(time.Hour)},
+			target:  "b",
+			reason:  "a failed 2 checks",
+		},
+		{
+			name:    "recovering",
+			current: "b",
+			members: map[string]*groupMemberState{"a": healthy(10 * time.Second), "b": healthy(time.Hour)},
+			target:  "b",
+			reason:  "returning to it in 50s",
+		},
+		{
+			name:    "recovered",
+			current: "b",
+			members: map[string]*groupMemberState{"a": healthy(2 * time.Minute), "b": healthy(time.Hour)},
+			target:  "a",
+			reason:  "a has been healthy for 1m0s",
+		},
+		{
+			name:    "flap",
+			current: "b",
+			members: map[string]*groupMemberState{"a": failing(1), "b": healthy(time.Hour)},
+			target:  "b",
+			reason:  "a failed its last check",
+		},
+		{
+			name:    "flap before a recovered member",
+			current: "c",
+			members: map[string]*groupMemberState{"a": failing(1), "b": healthy(2 * time.Minute), "c": healthy(time.Hour)},
+			target:  "b",
+			reason:  "b has been healthy",
+		},
+		{
+			name:    "all failing",
+			current: "b",
+			members: map[string]*groupMemberState{"a": failing(3), "b": failing(2), "c": failing(5)},
+			target:  "b",
+			reason:  "all members are failing",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := &groupState{groupType: group.Type, members: test.members}
+			members := []string{"a", "b"}
+			if _, ok := test.members["c"]; ok {
+				members = append(members, "c")
+			}
+			target, reason := fallbackTarget(members, test.current, state, group, now)
+			if target != test.target || !strings.Contains(reason, test.reason) {
+				t.Errorf("got %s (%s), want %s (%s)", target, reason, test.target, test.reason)
+			}
+		})
+	}
+}
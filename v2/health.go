@@ -0,0 +1,250 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+	"github.com/sagernet/sing-box/common/urltest"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"google.golang.org/grpc"
+)
+
+const (
+	healthMaxSamples   = 100
+	healthPollInterval = 2 * time.Second
+	healthProbeTimeout = 10 * time.Second
+	healthSeedMaxAge   = 24 * time.Hour
+	healthDefaultURL   = "http://cp.cloudflare.com/"
+)
+
+var healthObserver = NewObserver[pb.OutboundHealthList](10)
+
+type HealthSample struct {
+	Time    time.Time
+	Delay   uint16
+	Success bool
+}
+
+// OutboundHealth is the url test history of one outbound tag.
+type OutboundHealth struct {
+	Id              string // outbound tag
+	Samples         []HealthSample
+	LastFailure     string
+	LastFailureTime time.Time
+}
+
+func (h *OutboundHealth) record(sample HealthSample, reason string) {
+	h.Samples = append(h.Samples, sample)
+	if len(h.Samples) > healthMaxSamples {
+		h.Samples = h.Samples[len(h.Samples)-healthMaxSamples:]
+	}
+	if !sample.Success {
+		h.LastFailure = reason
+		h.LastFailureTime = sample.Time
+	}
+}
+
+func (h *OutboundHealth) lastSuccess() *HealthSample {
+	for i := len(h.Samples) - 1; i >= 0; i-- {
+		if h.Samples[i].Success {
+			return &h.Samples[i]
+		}
+	}
+	return nil
+}
+
+// stats returns the success rate and the p50/p95 delay of successful tests.
+func (h *OutboundHealth) stats() (successRate float32, p50 uint16, p95 uint16) {
+	if len(h.Samples) == 0 {
+		return 0, 0, 0
+	}
+	var delays []uint16
+	for _, sample := range h.Samples {
+		if sample.Success {
+			delays = append(delays, sample.Delay)
+		}
+	}
+	successRate = float32(len(delays)) / float32(len(h.Samples))
+	if len(delays) == 0 {
+		return successRate, 0, 0
+	}
+	sort.Slice(delays, func(i, j int) bool { return delays[i] < delays[j] })
+	return successRate, percentile(delays, 50), percentile(delays, 95)
+}
+
+func percentile(sorted []uint16, p int) uint16 {
+	index := (len(sorted)*p+99)/100 - 1
+	if index < 0 {
+		index = 0
+	}
+	return sorted[index]
+}
+
+func (h *OutboundHealth) toPb() *pb.OutboundHealth {
+	successRate, p50, p95 := h.stats()
+	item := &pb.OutboundHealth{
+		Tag:               h.Id,
+		SuccessRate:       successRate,
+		Samples:           int32(len(h.Samples)),
+		P50Delay:          uint32(p50),
+		P95Delay:          uint32(p95),
+		LastFailureReason: h.LastFailure,
+	}
+	if last := h.lastSuccess(); last != nil {
+		item.LastSuccess = last.Time.Unix()
+		item.LastDelay = uint32(last.Delay)
+	}
+	if !h.LastFailureTime.IsZero() {
+		item.LastFailure = h.LastFailureTime.Unix()
+	}
+	return item
+}
+
+func healthList(items []*OutboundHealth) pb.OutboundHealthList {
+	sort.Slice(items, func(i, j int) bool { return items[i].Id < items[j].Id })
+	list := pb.OutboundHealthList{}
+	for _, item := range items {
+		list.Items = append(list.Items, item.toPb())
+	}
+	return list
+}
+
+// seedURLTestHistory fills the url test history of a new service with the
+// median delay of outbounds that recently passed, so that the auto group can
+// pick a good outbound before its first test round finishes.
+func seedURLTestHistory(storage *urltest.HistoryStorage, options option.Options) map[string]time.Time {
+	seeded := map[string]time.Time{}
+	items, err := db.GetTable[OutboundHealth]().All()
+	if err != nil {
+		return seeded
+	}
+	byTag := make(map[string]*OutboundHealth, len(items))
+	for _, item := range items {
+		byTag[item.Id] = item
+	}
+	for _, outbound := range options.Outbounds {
+		health, ok := byTag[outbound.Tag]
+		if !ok || len(health.Samples) == 0 || !health.Samples[len(health.Samples)-1].Success {
+			continue
+		}
+		last := health.Samples[len(health.Samples)-1]
+		if time.Since(last.Time) > healthSeedMaxAge {
+			continue
+		}
+		_, p50, _ := health.stats()
+		storage.StoreURLTestHistory(outbound.Tag, &urltest.History{Time: last.Time, Delay: p50})
+		seeded[outbound.Tag] = last.Time
+	}
+	return seeded
+}
+
+// healthRecorder watches the url test history of a running service and
+// stores every new result. The history only keeps successful tests, so a
+// failure shows up as a tag losing its entry. The health of the outbounds
+// is loaded once and kept, so a poll only touches the db to store changes.
+type healthRecorder struct {
+	storage *urltest.HistoryStorage
+	table   *db.Table[OutboundHealth]
+	tags    []string
+	last    map[string]time.Time
+	healths map[string]*OutboundHealth
+}
+
+func newHealthRecorder(storage *urltest.HistoryStorage, options option.Options, seeded map[string]time.Time) *healthRecorder {
+	recorder := &healthRecorder{
+		storage: storage,
+		table:   db.GetTable[OutboundHealth](),
+		last:    seeded,
+		healths: map[string]*OutboundHealth{},
+	}
+	for _, outbound := range options.Outbounds {
+		switch outbound.Type {
+		case C.TypeSelector, C.TypeURLTest, C.TypeDirect, C.TypeBlock, C.TypeDNS:
+			continue
+		}
+		recorder.tags = append(recorder.tags, outbound.Tag)
+	}
+	if items, err := recorder.table.All(); err == nil {
+		for _, item := range items {
+			recorder.healths[item.Id] = item
+		}
+	}
+	return recorder
+}
+
+func (r *healthRecorder) run(ctx context.Context) {
+	ticker := time.NewTicker(healthPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.poll()
+		}
+	}
+}
+
+func (r *healthRecorder) poll() {
+	var changed []*OutboundHealth
+	for _, tag := range r.tags {
+		history := r.storage.LoadURLTestHistory(tag)
+		last, seen := r.last[tag]
+		switch {
+		case history != nil && (!seen || history.Time.After(last)):
+			changed = append(changed, r.health(tag))
+			changed[len(changed)-1].record(HealthSample{Time: history.Time, Delay: history.Delay, Success: true}, "")
+			r.last[tag] = history.Time
+		case history == nil && seen:
+			changed = append(changed, r.health(tag))
+			changed[len(changed)-1].record(HealthSample{Time: time.Now()}, "url test failed")
+			delete(r.last, tag)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+	if err := r.table.UpdateInsert(changed...); err != nil {
+		Log(pb.LogLevel_WARNING, pb.LogType_CORE, "failed to store outbound health: "+err.Error())
+		return
+	}
+	healthObserver.Emit(healthList(changed))
+}
+
+func (r *healthRecorder) health(tag string) *OutboundHealth {
+	health, ok := r.healths[tag]
+	if !ok {
+		health = &OutboundHealth{Id: tag}
+		r.healths[tag] = health
+	}
+	return health
+}
+
+func (s *CoreService) OutboundHealthInfo(req *pb.Empty, stream grpc.ServerStreamingServer[pb.OutboundHealthList]) error {
+	sub, done, _ := healthObserver.Subscribe()
+	defer healthObserver.UnSubscribe(sub)
+
+	items, err := db.GetTable[OutboundHealth]().All()
+	if err != nil {
+		return fmt.Errorf("failed to load outbound health: %w", err)
+	}
+	list := healthList(items)
+	stream.Send(&list)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-done:
+			return nil
+		case info := <-sub:
+			stream.Send(&info)
+		case <-time.After(1000 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hiddify/hiddify-core/v2/db"
+	"github.com/sagernet/sing-box/common/urltest"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		sorted []uint16
+		p      int
+		want   uint16
+	}{
+		{[]uint16{10}, 50, 10},
+		{[]uint16{10}, 95, 10},
+		{[]uint16{10, 20}, 50, 10},
+		{[]uint16{10, 20}, 95, 20},
+		{[]uint16{10, 20, 30, 40}, 50, 20},
+		{[]uint16{10, 20, 30, 40}, 95, 40},
+		{[]uint16{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 50, 5},
+		{[]uint16{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 95, 10},
+	}
+	for _, test := range tests {
+		if got := percentile(test.sorted, test.p); got != test.want {
+			t.Errorf("p%d of %v: got %d, want %d", test.p, test.sorted, got, test.want)
+		}
+	}
+}
+
+func TestOutboundHealthStats(t *testing.T) {
+	sample := func(delay uint16) HealthSample {
+		return HealthSample{Time: time.Now(), Delay: delay, Success: delay > 0}
+	}
+	tests := []struct {
+		name        string
+		delays      []uint16 // 0 is a failure
+		successRate float32
+		p50, p95    uint16
+	}{
+		{"empty", nil, 0, 0, 0},
+		{"failures only", []uint16{0, 0}, 0, 0, 0},
+		{"mixed", []uint16{300, 0, 100, 0, 200}, 0.6, 200, 300},
+		{"unsorted", []uint16{50, 40, 30, 20, 10}, 1, 30, 50},
+	}
+	for _, test := range tests {
+		var health OutboundHealth
+		for _, delay := range test.delays {
+			health.record(sample(delay), "failed")
+		}
+		successRate, p50, p95 := health.stats()
+		if successRate != test.successRate || p50 != test.p50 || p95 != test.p95 {
+			t.Errorf("%s: got %v %d %d", test.name, successRate, p50, p95)
+		}
+	}
+}
+
+func TestOutboundHealthRollingWindow(t *testing.T) {
+	var health OutboundHealth
+	for range healthMaxSamples {
+		health.record(HealthSample{Time: time.Now()}, "timeout")
+	}
+	for range healthMaxSamples / 2 {
+		health.record(HealthSample{Time: time.Now(), Delay: 100, Success: true}, "")
+	}
+	if len(health.Samples) != healthMaxSamples {
+		t.Fatalf("expected %d samples, got %d", healthMaxSamples, len(health.Samples))
+	}
+	if successRate, _, _ := health.stats(); successRate != 0.5 {
+		t.Errorf("only the last %d samples should count, got %v", healthMaxSamples, successRate)
+	}
+	if health.LastFailure != "timeout" {
+		t.Errorf("unexpected last failure %q", health.LastFailure)
+	}
+}
+
+func TestSeedURLTestHistory(t *testing.T) {
+	t.Chdir(t.TempDir())
+	now := time.Now()
+	err := db.GetTable[OutboundHealth]().UpdateInsert(
+		&OutboundHealth{Id: "recent", Samples: []HealthSample{
+			{Time: now.Add(-time.Hour), Delay: 300, Success: true},
+			{Time: now.Add(-time.Minute), Delay: 100, Success: true},
+		}},
+		&OutboundHealth{Id: "old", Samples: []HealthSample{{Time: now.Add(-2 * healthSeedMaxAge), Delay: 100, Success: true}}},
+		&OutboundHealth{Id: "failing", Samples: []HealthSample{{Time: now, Delay: 100, Success: true}, {Time: now}}},
+		&OutboundHealth{Id: "unused", Samples: []HealthSample{{Time: now, Delay: 100, Success: true}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := option.Options{Outbounds: []option.Outbound{
+		{Type: C.TypeVLESS, Tag: "recent"},
+		{Type: C.TypeVLESS, Tag: "old"},
+		{Type: C.TypeVLESS, Tag: "failing"},
+		{Type: C.TypeVLESS, Tag: "new"},
+	}}
+
+	storage := urltest.NewHistoryStorage()
+	defer storage.Close()
+	seeded := seedURLTestHistory(storage, options)
+	if len(seeded) != 1 || !seeded["recent"].Equal(now.Add(-time.Minute)) {
+		t.Errorf("unexpected seeded outbounds: %v", seeded)
+	}
+	if history := storage.LoadURLTestHistory("recent"); history == nil || history.Delay != 100 {
+		t.Errorf("recent outbound should be seeded with its p50: %+v", history)
+	}
+	for _, tag := range []string{"old", "failing", "new", "unused"} {
+		if history := storage.LoadURLTestHistory(tag); history != nil {
+			t.Errorf("%s should not be seeded: %+v", tag, history)
+		}
+	}
+}
+
+func TestHealthRecorderPoll(t *testing.T) {
+	t.Chdir(t.TempDir())
+	storage := urltest.NewHistoryStorage()
+	defer storage.Close()
+	options := option.Options{Outbounds: []option.Outbound{
+		{Type: C.TypeSelector, Tag: "select"},
+		{Type: C.TypeVLESS, Tag: "a"},
+	}}
+	recorder := newHealthRecorder(storage, options, map[string]time.Time{})
+	if len(recorder.tags) != 1 || recorder.tags[0] != "a" {
+		t.Fatalf("groups should not be recorded: %v", recorder.tags)
+	}
+
+	storage.StoreURLTestHistory("a", &urltest.History{Time: time.Now(), Delay: 120})
+	recorder.poll()
+	recorder.poll()
+	storage.DeleteURLTestHistory("a")
+	recorder.poll()
+
+	health, err := db.GetTable[OutboundHealth]().Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(health.Samples) != 2 || !health.Samples[0].Success || health.Samples[0].Delay != 120 || health.Samples[1].Success {
+		t.Errorf("expected a success and a failure: %+v", health.Samples)
+	}
+	if health.LastFailure == "" {
+		t.Error("failure reason is missing")
+	}
+}
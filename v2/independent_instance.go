@@ -8,9 +8,9 @@ import (
 	"time"
 
 	"github.com/hiddify/hiddify-core/config"
-	"golang.org/x/net/proxy"
 
 	"github.com/sagernet/sing-box/experimental/libbox"
+	"github.com/sagernet/sing-box/log"
 	"github.com/sagernet/sing-box/option"
 )
 
@@ -36,6 +36,47 @@ func RunInstanceString(hiddifySettings *config.HiddifyOptions, proxiesInput stri
 }
 
 func RunInstance(hiddifySettings *config.HiddifyOptions, singconfig *option.Options) (*HiddifyService, error) {
+	return runInstance(hiddifySettings, singconfig, instanceOptions{})
+}
+
+// RunEmbeddedInstance is like RunInstance but opens no local proxy; the
+// service is only reached with its Dialer, DialContext, ListenPacket and
+// HTTPClient.
+func RunEmbeddedInstance(hiddifySettings *config.HiddifyOptions, singconfig *option.Options) (*HiddifyService, error) {
+	return runInstance(hiddifySettings, singconfig, instanceOptions{embedded: true})
+}
+
+// RunEmbeddedInstanceWithLog is like RunEmbeddedInstance but writes the logs
+// of the instance, its groups and warp registrations to logOutput instead of
+// stdout.
+func RunEmbeddedInstanceWithLog(hiddifySettings *config.HiddifyOptions, singconfig *option.Options, logOutput io.Writer) (*HiddifyService, error) {
+	return runInstance(hiddifySettings, singconfig, instanceOptions{embedded: true, logOutput: logOutput})
+}
+
+type instanceOptions struct {
+	logWriter log.PlatformWriter
+	// logOutput, if set, receives the logs that would go to stdout.
+	logOutput io.Writer
+	// embedded instances have no inbounds.
+	embedded bool
+}
+
+// writerLog is a log.PlatformWriter printing to a writer.
+type writerLog struct {
+	output io.Writer
+}
+
+func (w writerLog) DisableColors() bool {
+	return true
+}
+
+func (w writerLog) WriteMessage(level log.Level, message string) {
+	fmt.Fprintln(w.output, message)
+}
+
+// runInstance starts an isolated box on a random port. It runs its own
+// groups and counts its own traffic, without a clash api.
+func runInstance(hiddifySettings *config.HiddifyOptions, singconfig *option.Options, instanceOpt instanceOptions) (*HiddifyService, error) {
 	if hiddifySettings == nil {
 		hiddifySettings = config.DefaultHiddifyOptions()
 	}
@@ -50,12 +91,28 @@ func RunInstance(hiddifySettings *config.HiddifyOptions, singconfig *option.Opti
 	hiddifySettings.BlockAds = false
 	hiddifySettings.LogFile = "/dev/null"
 
-	finalConfigs, err := config.BuildConfig(*hiddifySettings, *singconfig)
+	env := config.DefaultBuildEnv()
+	if instanceOpt.logOutput != nil {
+		env.Warp = config.NewWarpAccounts(instanceOpt.logOutput)
+		if instanceOpt.logWriter == nil {
+			instanceOpt.logWriter = writerLog{instanceOpt.logOutput}
+		}
+	}
+	finalConfigs, err := config.BuildConfigWithEnv(*hiddifySettings, *singconfig, env)
 	if err != nil {
 		return nil, err
 	}
+	// the cache file belongs to the main core
+	if finalConfigs.Experimental != nil {
+		finalConfigs.Experimental.CacheFile = nil
+	}
+	listenPort := hiddifySettings.InboundOptions.MixedPort
+	if instanceOpt.embedded {
+		finalConfigs.Inbounds = nil
+		listenPort = 0
+	}
 
-	instance, err := NewService(*finalConfigs)
+	instance, err := newService(*finalConfigs, serviceOptions{logWriter: instanceOpt.logWriter, logOutput: instanceOpt.logOutput, isolated: true, options: hiddifySettings})
 	if err != nil {
 		return nil, err
 	}
@@ -64,19 +121,48 @@ func RunInstance(hiddifySettings *config.HiddifyOptions, singconfig *option.Opti
 		return nil, err
 	}
 	<-time.After(250 * time.Millisecond)
-	hservice := &HiddifyService{libbox: instance, ListenPort: hiddifySettings.InboundOptions.MixedPort}
+	hservice := &HiddifyService{libbox: instance, ListenPort: listenPort}
 	hservice.PingCloudflare()
 	return hservice, nil
 }
 
 type HiddifyService struct {
-	libbox     *libbox.BoxService
+	libbox *libbox.BoxService
+	// ListenPort is the port of the local mixed proxy, 0 for embedded
+	// instances.
 	ListenPort uint16
 }
 
-// dialer, err := s.libbox.GetInstance().Router().Dialer(context.Background())
+// InstanceTraffic is the current speed and the total traffic of an instance
+// in bytes.
+type InstanceTraffic struct {
+	Uplink        int64
+	Downlink      int64
+	UplinkTotal   int64
+	DownlinkTotal int64
+	Connections   int
+}
+
+func (s *HiddifyService) Traffic() (InstanceTraffic, error) {
+	if s.libbox == nil {
+		return InstanceTraffic{}, fmt.Errorf("instance is not running")
+	}
+	server, ok := routedServerOf(s.libbox.GetInstance().Router())
+	if !ok {
+		return InstanceTraffic{}, fmt.Errorf("instance has no traffic stats")
+	}
+	manager := server.traffic
+	var traffic InstanceTraffic
+	traffic.Uplink, traffic.Downlink = manager.Now()
+	traffic.UplinkTotal, traffic.DownlinkTotal = manager.Total()
+	traffic.Connections = len(manager.Snapshot().Connections)
+	return traffic, nil
+}
 
 func (s *HiddifyService) Close() error {
+	if s.libbox == nil {
+		return nil
+	}
 	return s.libbox.Close()
 }
 
@@ -97,20 +183,7 @@ func (s *HiddifyService) ContentFromURL(method string, url string, timeout time.
 		return "", err
 	}
 
-	dialer, err := proxy.SOCKS5("tcp", fmt.Sprintf("127.0.0.1:%d", s.ListenPort), nil, proxy.Direct)
-	if err != nil {
-		return "", err
-	}
-
-	transport := &http.Transport{
-		Dial: dialer.Dial,
-	}
-
-	client := &http.Client{
-		Transport: transport,
-		Timeout:   timeout,
-	}
-
+	client := s.HTTPClient(timeout)
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -137,10 +210,6 @@ func (s *HiddifyService) PingCloudflare() (time.Duration, error) {
 	return s.Ping("http://cp.cloudflare.com")
 }
 
-// func (s *HiddifyService) RawConnection(ctx context.Context, url string) (net.Conn, error) {
-// 	return
-// }
-
 func (s *HiddifyService) PingAverage(url string, count int) (time.Duration, error) {
 	if count <= 0 {
 		return -1, fmt.Errorf("count must be greater than 0")
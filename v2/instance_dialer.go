@@ -0,0 +1,232 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hiddify/hiddify-core/v2/servertest"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing/common"
+	"github.com/sagernet/sing/common/bufio"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+)
+
+// dialerInboundTag is the inbound of the connections of a Dialer in rules
+// and connection lists.
+const dialerInboundTag = "dialer"
+
+// Dialer connects through the router of a service instead of its local
+// proxy. Without an outbound its connections are routed like the ones of an
+// inbound, through the route rules and groups of the service.
+type Dialer struct {
+	router   adapter.Router
+	outbound string
+}
+
+// Dialer returns a dialer pinned to the outbound tag, or routing every
+// connection if tag is empty.
+func (s *HiddifyService) Dialer(tag string) *Dialer {
+	return &Dialer{router: s.libbox.GetInstance().Router(), outbound: tag}
+}
+
+func (s *HiddifyService) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	return s.Dialer("").DialContext(ctx, network, address)
+}
+
+func (s *HiddifyService) ListenPacket(ctx context.Context, destination string) (net.PacketConn, error) {
+	return s.Dialer("").ListenPacket(ctx, destination)
+}
+
+func (s *HiddifyService) HTTPClient(timeout time.Duration) *http.Client {
+	return s.Dialer("").HTTPClient(timeout)
+}
+
+func (d *Dialer) dialer(network string) (N.Dialer, error) {
+	outbound, ok := d.router.Outbound(d.outbound)
+	if !ok {
+		return nil, fmt.Errorf("outbound %s not found", d.outbound)
+	}
+	if !common.Contains(outbound.Network(), network) {
+		return nil, fmt.Errorf("outbound %s does not support %s", d.outbound, network)
+	}
+	return outbound, nil
+}
+
+func (d *Dialer) metadata(network string, destination M.Socksaddr) adapter.InboundContext {
+	return adapter.InboundContext{
+		Inbound:     dialerInboundTag,
+		InboundType: dialerInboundTag,
+		Network:     network,
+		Destination: destination,
+	}
+}
+
+// route picks the outbound of a connection like the router does for the
+// connections of an inbound: the outbound of the first matching route rule,
+// or the default one.
+func (d *Dialer) route(metadata *adapter.InboundContext) (adapter.Outbound, adapter.Rule, error) {
+	var matched adapter.Rule
+	outbound, err := d.router.DefaultOutbound(metadata.Network)
+	for _, rule := range d.router.Rules() {
+		metadata.ResetRuleCache()
+		if !rule.Match(metadata) {
+			continue
+		}
+		if ruleOutbound, ok := d.router.Outbound(rule.Outbound()); ok {
+			matched, outbound, err = rule, ruleOutbound, nil
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !common.Contains(outbound.Network(), metadata.Network) {
+		return nil, nil, fmt.Errorf("outbound %s does not support %s", outbound.Tag(), metadata.Network)
+	}
+	return outbound, matched, nil
+}
+
+// dialRouted connects through the outbound the route rules pick. ctx bounds
+// the dial, as for a pinned outbound.
+func (d *Dialer) dialRouted(ctx context.Context, destination M.Socksaddr) (net.Conn, error) {
+	metadata := d.metadata(N.NetworkTCP, destination)
+	outbound, rule, err := d.route(&metadata)
+	if err != nil {
+		return nil, err
+	}
+	ctx = adapter.WithContext(ctx, &metadata)
+	conn, err := outbound.DialContext(ctx, N.NetworkTCP, destination)
+	if err != nil {
+		return nil, err
+	}
+	return d.track(ctx, conn, metadata, rule), nil
+}
+
+func (d *Dialer) listenRouted(ctx context.Context, destination M.Socksaddr) (net.PacketConn, error) {
+	metadata := d.metadata(N.NetworkUDP, destination)
+	outbound, rule, err := d.route(&metadata)
+	if err != nil {
+		return nil, err
+	}
+	ctx = adapter.WithContext(ctx, &metadata)
+	conn, err := outbound.ListenPacket(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+	return d.trackPacket(ctx, conn, metadata, rule), nil
+}
+
+// track lists conn with the connections of the service.
+func (d *Dialer) track(ctx context.Context, conn net.Conn, metadata adapter.InboundContext, rule adapter.Rule) net.Conn {
+	server, ok := routedServerOf(d.router)
+	if !ok {
+		return conn
+	}
+	conn, tracker := server.RoutedConnection(ctx, conn, metadata, rule)
+	return &trackedConn{Conn: conn, tracker: tracker}
+}
+
+func (d *Dialer) trackPacket(ctx context.Context, conn net.PacketConn, metadata adapter.InboundContext, rule adapter.Rule) net.PacketConn {
+	server, ok := routedServerOf(d.router)
+	if !ok {
+		return conn
+	}
+	tracked, tracker := server.RoutedPacketConnection(ctx, bufio.NewPacketConn(conn), metadata, rule)
+	return &trackedPacketConn{PacketConn: bufio.NewNetPacketConn(tracked), tracker: tracker}
+}
+
+// trackedConn leaves its tracker when it is closed, as the router does when
+// it is done with a connection.
+type trackedConn struct {
+	net.Conn
+	tracker adapter.Tracker
+	once    sync.Once
+}
+
+func (c *trackedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.tracker.Leave)
+	return err
+}
+
+type trackedPacketConn struct {
+	net.PacketConn
+	tracker adapter.Tracker
+	once    sync.Once
+}
+
+func (c *trackedPacketConn) Close() error {
+	err := c.PacketConn.Close()
+	c.once.Do(c.tracker.Leave)
+	return err
+}
+
+// DialContext connects to address over tcp or udp, like net.Dialer.
+func (d *Dialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	network = N.NetworkName(network)
+	if network != N.NetworkTCP && network != N.NetworkUDP {
+		return nil, fmt.Errorf("unsupported network %s", network)
+	}
+	destination := M.ParseSocksaddr(address)
+	if !destination.IsValid() || destination.Port == 0 {
+		return nil, fmt.Errorf("invalid address %s", address)
+	}
+	if d.outbound == "" {
+		if network == N.NetworkUDP {
+			conn, err := d.listenRouted(ctx, destination)
+			if err != nil {
+				return nil, err
+			}
+			return bufio.NewBindPacketConn(conn, destination), nil
+		}
+		return d.dialRouted(ctx, destination)
+	}
+	dialer, err := d.dialer(network)
+	if err != nil {
+		return nil, err
+	}
+	return dialer.DialContext(ctx, network, destination)
+}
+
+// ListenPacket opens a udp socket whose packets go through the outbound.
+// Outbounds that carry udp over a single tunnel only reach destination.
+func (d *Dialer) ListenPacket(ctx context.Context, destination string) (net.PacketConn, error) {
+	addr := M.ParseSocksaddr(destination)
+	if !addr.IsValid() {
+		return nil, fmt.Errorf("invalid address %s", destination)
+	}
+	if d.outbound == "" {
+		return d.listenRouted(ctx, addr)
+	}
+	dialer, err := d.dialer(N.NetworkUDP)
+	if err != nil {
+		return nil, err
+	}
+	return dialer.ListenPacket(ctx, addr)
+}
+
+// HTTPClient returns a client whose connections go through the dialer.
+func (d *Dialer) HTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         d.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		Timeout: timeout,
+	}
+}
+
+// SpeedTest measures latency, jitter and throughput through the dialer.
+func (d *Dialer) SpeedTest(ctx context.Context, options servertest.SpeedOptions) (servertest.SpeedResult, error) {
+	return servertest.SpeedTest(ctx, d.DialContext, options)
+}
+
+func (s *HiddifyService) SpeedTest(ctx context.Context, options servertest.SpeedOptions) (servertest.SpeedResult, error) {
+	return s.Dialer("").SpeedTest(ctx, options)
+}
@@ -0,0 +1,177 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+)
+
+func runTestInstance(t *testing.T) *HiddifyService {
+	t.Helper()
+	service, err := RunEmbeddedInstance(config.DefaultHiddifyOptions(), &option.Options{
+		Outbounds: []option.Outbound{{Type: C.TypeDirect, Tag: "out"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { service.Close() })
+	return service
+}
+
+func TestInstanceDialer(t *testing.T) {
+	service := runTestInstance(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "hello")
+	}))
+	defer server.Close()
+	resp, err := service.HTTPClient(5 * time.Second).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "hello" {
+		t.Errorf("unexpected body %q", body)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+	for _, tag := range []string{"", "out"} {
+		conn, err := service.Dialer(tag).DialContext(ctx, "tcp", listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+		if _, err := conn.Write([]byte("ping")); err != nil {
+			t.Fatal(err)
+		}
+		reply := make([]byte, 4)
+		if _, err := io.ReadFull(conn, reply); err != nil || string(reply) != "ping" {
+			t.Errorf("dialer %q: unexpected echo %q: %v", tag, reply, err)
+		}
+		conn.Close()
+	}
+
+	if _, err := service.Dialer("missing").DialContext(ctx, "tcp", listener.Addr().String()); err == nil {
+		t.Error("expected error for a missing outbound")
+	}
+	if _, err := service.DialContext(ctx, "tcp", "no-port"); err == nil {
+		t.Error("expected error for an address without port")
+	}
+}
+
+// testHangingOutbound dials until the dial is canceled.
+type testHangingOutbound struct {
+	adapter.Outbound
+}
+
+func (testHangingOutbound) Tag() string {
+	return "hanging"
+}
+
+func (testHangingOutbound) Network() []string {
+	return []string{N.NetworkTCP}
+}
+
+func (testHangingOutbound) DialContext(ctx context.Context, network string, destination M.Socksaddr) (net.Conn, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestDialerRoutedErrors(t *testing.T) {
+	router := &testRouter{outbounds: map[string]adapter.Outbound{"hanging": testHangingOutbound{}}, final: "hanging"}
+	dialer := &Dialer{router: router}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := dialer.DialContext(ctx, "tcp", "example.com:443"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the dial to end with its context: %v", err)
+	}
+	if _, err := dialer.DialContext(context.Background(), "udp", "example.com:443"); err == nil {
+		t.Error("expected error for an outbound without udp")
+	}
+	router.final = "missing"
+	if _, err := dialer.DialContext(context.Background(), "tcp", "example.com:443"); err == nil {
+		t.Error("expected error without a default outbound")
+	}
+}
+
+func TestInstanceListenPacket(t *testing.T) {
+	service := runTestInstance(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	echo, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		p := make([]byte, 1500)
+		for {
+			n, addr, err := echo.ReadFrom(p)
+			if err != nil {
+				return
+			}
+			echo.WriteTo(p[:n], addr)
+		}
+	}()
+
+	conn, err := service.ListenPacket(ctx, echo.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.WriteTo([]byte("ping"), echo.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+	p := make([]byte, 64)
+	n, from, err := conn.ReadFrom(p)
+	if err != nil || string(p[:n]) != "ping" || from.String() != echo.LocalAddr().String() {
+		t.Errorf("unexpected echo %q from %v: %v", p[:n], from, err)
+	}
+
+	udp, err := service.DialContext(ctx, "udp", echo.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer udp.Close()
+	udp.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := udp.Write([]byte("pong")); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := udp.Read(p); err != nil || string(p[:n]) != "pong" {
+		t.Errorf("unexpected udp echo %q: %v", p[:n], err)
+	}
+}
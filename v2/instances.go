@@ -0,0 +1,306 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/service_manager"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing/common/observable"
+	"google.golang.org/grpc"
+)
+
+// instanceLogBacklog is how many recent log messages of an instance are sent
+// to a new log listener.
+const instanceLogBacklog = 100
+
+// managedInstance is an instance started over rpc, next to the main core.
+type managedInstance struct {
+	name      string
+	service   *HiddifyService
+	createdAt time.Time
+
+	logAccess   sync.Mutex
+	logBacklog  []pb.LogMessage
+	logObserver *observable.Observer[pb.LogMessage]
+}
+
+func (i *managedInstance) DisableColors() bool {
+	return true
+}
+
+func (i *managedInstance) WriteMessage(level log.Level, message string) {
+	entry := pb.LogMessage{Level: instanceLogLevel(level), Type: pb.LogType_CORE, Message: message}
+	i.logAccess.Lock()
+	i.logBacklog = append(i.logBacklog, entry)
+	if len(i.logBacklog) > instanceLogBacklog {
+		i.logBacklog = i.logBacklog[len(i.logBacklog)-instanceLogBacklog:]
+	}
+	i.logAccess.Unlock()
+	i.logObserver.Emit(entry)
+}
+
+func (i *managedInstance) backlog() []pb.LogMessage {
+	i.logAccess.Lock()
+	defer i.logAccess.Unlock()
+	return append([]pb.LogMessage(nil), i.logBacklog...)
+}
+
+func instanceLogLevel(level log.Level) pb.LogLevel {
+	switch level {
+	case log.LevelPanic, log.LevelFatal:
+		return pb.LogLevel_FATAL
+	case log.LevelError:
+		return pb.LogLevel_ERROR
+	case log.LevelWarn:
+		return pb.LogLevel_WARNING
+	case log.LevelInfo:
+		return pb.LogLevel_INFO
+	default:
+		return pb.LogLevel_DEBUG
+	}
+}
+
+func (i *managedInstance) toPb() *pb.InstanceInfo {
+	info := &pb.InstanceInfo{
+		Name:      i.name,
+		MixedPort: uint32(i.service.ListenPort),
+		CreatedAt: i.createdAt.Unix(),
+	}
+	if traffic, err := i.service.Traffic(); err == nil {
+		info.Uplink = traffic.Uplink
+		info.Downlink = traffic.Downlink
+		info.UplinkTotal = traffic.UplinkTotal
+		info.DownlinkTotal = traffic.DownlinkTotal
+		info.Connections = int32(traffic.Connections)
+	}
+	return info
+}
+
+func (i *managedInstance) close() error {
+	err := i.service.Close()
+	i.logObserver.Close()
+	return err
+}
+
+// instanceManager keeps the named instances. They run until destroyed or
+// until the core shuts down.
+type instanceManager struct {
+	access    sync.Mutex
+	instances map[string]*managedInstance
+}
+
+var instances *instanceManager
+
+// startInstance starts the box of a managed instance.
+var startInstance = runInstance
+
+func (m *instanceManager) Start() error {
+	return nil
+}
+
+func (m *instanceManager) Close() error {
+	m.access.Lock()
+	closing := m.instances
+	m.instances = map[string]*managedInstance{}
+	m.access.Unlock()
+	for name, instance := range closing {
+		if err := instance.close(); err != nil {
+			Log(pb.LogLevel_WARNING, pb.LogType_CORE, fmt.Sprintf("instance %s: %v", name, err))
+		}
+	}
+	return nil
+}
+
+func (m *instanceManager) get(name string) (*managedInstance, error) {
+	m.access.Lock()
+	defer m.access.Unlock()
+	instance, ok := m.instances[name]
+	if !ok {
+		return nil, fmt.Errorf("instance %s not found", name)
+	}
+	return instance, nil
+}
+
+// reserve claims name while its instance starts, so concurrent creates of a
+// name fail instead of leaking an instance.
+func (m *instanceManager) reserve(name string) error {
+	m.access.Lock()
+	defer m.access.Unlock()
+	if _, ok := m.instances[name]; ok {
+		return fmt.Errorf("instance %s already exists", name)
+	}
+	m.instances[name] = nil
+	return nil
+}
+
+func (m *instanceManager) release(name string, instance *managedInstance) {
+	m.access.Lock()
+	defer m.access.Unlock()
+	if instance == nil {
+		delete(m.instances, name)
+	} else {
+		m.instances[name] = instance
+	}
+}
+
+func (m *instanceManager) running() []*managedInstance {
+	m.access.Lock()
+	defer m.access.Unlock()
+	running := make([]*managedInstance, 0, len(m.instances))
+	for _, instance := range m.instances {
+		if instance != nil {
+			running = append(running, instance)
+		}
+	}
+	sort.Slice(running, func(a, b int) bool { return running[a].name < running[b].name })
+	return running
+}
+
+func (s *CoreService) CreateInstance(ctx context.Context, in *pb.CreateInstanceRequest) (*pb.InstanceResponse, error) {
+	return CreateInstance(in)
+}
+
+// CreateInstance starts a named instance from a config and settings. It has
+// its own ports, logs and stats and never touches the main core.
+func CreateInstance(in *pb.CreateInstanceRequest) (*pb.InstanceResponse, error) {
+	if in.Name == "" {
+		return instanceFailed(fmt.Errorf("instance name is required"))
+	}
+	settings := *ruleSetOptions()
+	if in.HiddifySettingsJson != "" {
+		parsed, err := parseHiddifySettings(in.HiddifySettingsJson)
+		if err != nil {
+			return instanceFailed(err)
+		}
+		settings = *parsed
+	}
+	content := in.Content
+	if content == "" {
+		var profile *Profile
+		var err error
+		if in.ProfileId == "" {
+			profile, err = ActiveProfile()
+		} else {
+			profile, err = getProfile(in.ProfileId)
+		}
+		if err != nil {
+			return instanceFailed(err)
+		}
+		content = profile.Content
+	}
+	parsed, err := parseProfileContent(in.Name, content, false)
+	if err != nil {
+		return instanceFailed(err)
+	}
+	input, err := readOptions(string(parsed))
+	if err != nil {
+		return instanceFailed(err)
+	}
+
+	if err := instances.reserve(in.Name); err != nil {
+		return instanceFailed(err)
+	}
+	instance := &managedInstance{
+		name:        in.Name,
+		createdAt:   time.Now(),
+		logObserver: NewObserver[pb.LogMessage](10),
+	}
+	ruleSets.prepare(&settings)
+	instance.service, err = startInstance(&settings, &input, instanceOptions{logWriter: instance})
+	if err != nil {
+		instances.release(in.Name, nil)
+		return instanceFailed(err)
+	}
+	instances.release(in.Name, instance)
+	Log(pb.LogLevel_INFO, pb.LogType_CORE, fmt.Sprintf("instance %s started on port %d", in.Name, instance.service.ListenPort))
+	return &pb.InstanceResponse{ResponseCode: pb.ResponseCode_OK, Instance: instance.toPb()}, nil
+}
+
+func (s *CoreService) ListInstances(ctx context.Context, in *pb.Empty) (*pb.InstanceList, error) {
+	return ListInstances()
+}
+
+func ListInstances() (*pb.InstanceList, error) {
+	list := &pb.InstanceList{ResponseCode: pb.ResponseCode_OK}
+	for _, instance := range instances.running() {
+		list.Items = append(list.Items, instance.toPb())
+	}
+	return list, nil
+}
+
+func (s *CoreService) InspectInstance(ctx context.Context, in *pb.InstanceRequest) (*pb.InstanceResponse, error) {
+	return InspectInstance(in)
+}
+
+func InspectInstance(in *pb.InstanceRequest) (*pb.InstanceResponse, error) {
+	instance, err := instances.get(in.Name)
+	if err != nil || instance == nil {
+		return instanceFailed(fmt.Errorf("instance %s not found", in.Name))
+	}
+	return &pb.InstanceResponse{ResponseCode: pb.ResponseCode_OK, Instance: instance.toPb()}, nil
+}
+
+func (s *CoreService) DestroyInstance(ctx context.Context, in *pb.InstanceRequest) (*pb.Response, error) {
+	return DestroyInstance(in)
+}
+
+func DestroyInstance(in *pb.InstanceRequest) (*pb.Response, error) {
+	instance, err := instances.get(in.Name)
+	if err != nil || instance == nil {
+		err = fmt.Errorf("instance %s not found", in.Name)
+		return &pb.Response{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+	}
+	instances.release(in.Name, nil)
+	if err := instance.close(); err != nil {
+		return &pb.Response{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+	}
+	Log(pb.LogLevel_INFO, pb.LogType_CORE, fmt.Sprintf("instance %s destroyed", in.Name))
+	return &pb.Response{ResponseCode: pb.ResponseCode_OK}, nil
+}
+
+// InstanceLogs sends the recent logs of an instance and then follows them
+// until the instance is destroyed.
+func (s *CoreService) InstanceLogs(in *pb.InstanceRequest, stream grpc.ServerStreamingServer[pb.LogMessage]) error {
+	instance, err := instances.get(in.Name)
+	if err != nil || instance == nil {
+		return fmt.Errorf("instance %s not found", in.Name)
+	}
+	logSub, stopch, err := instance.logObserver.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer instance.logObserver.UnSubscribe(logSub)
+	for _, entry := range instance.backlog() {
+		entry := entry
+		stream.Send(&entry)
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-stopch:
+			return nil
+		case entry := <-logSub:
+			stream.Send(&entry)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+func instanceFailed(err error) (*pb.InstanceResponse, error) {
+	return &pb.InstanceResponse{
+		ResponseCode: pb.ResponseCode_FAILED,
+		Message:      err.Error(),
+	}, err
+}
+
+func init() {
+	instances = &instanceManager{instances: map[string]*managedInstance{}}
+	service_manager.Register(instances)
+}
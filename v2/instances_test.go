@@ -0,0 +1,84 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/sagernet/sing-box/option"
+)
+
+func TestInstanceManager(t *testing.T) {
+	oldInstances, oldStart := instances, startInstance
+	t.Cleanup(func() { instances, startInstance = oldInstances, oldStart })
+	instances = &instanceManager{instances: map[string]*managedInstance{}}
+	var failStart bool
+	startInstance = func(settings *config.HiddifyOptions, input *option.Options, instanceOpt instanceOptions) (*HiddifyService, error) {
+		if failStart {
+			return nil, fmt.Errorf("start failed")
+		}
+		return &HiddifyService{ListenPort: 2080}, nil
+	}
+	content := `{"outbounds":[{"type":"direct","tag":"direct"}]}`
+
+	if _, err := CreateInstance(&pb.CreateInstanceRequest{Content: content}); err == nil {
+		t.Error("expected error without a name")
+	}
+	resp, err := CreateInstance(&pb.CreateInstanceRequest{Name: "a", Content: content})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Instance.Name != "a" || resp.Instance.MixedPort != 2080 {
+		t.Errorf("unexpected instance: %+v", resp.Instance)
+	}
+	if _, err := CreateInstance(&pb.CreateInstanceRequest{Name: "a", Content: content}); err == nil {
+		t.Error("expected error for an existing name")
+	}
+
+	// a name is taken while its instance starts
+	if err := instances.reserve("b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CreateInstance(&pb.CreateInstanceRequest{Name: "b", Content: content}); err == nil {
+		t.Error("expected error for a reserved name")
+	}
+	if _, err := InspectInstance(&pb.InstanceRequest{Name: "b"}); err == nil {
+		t.Error("a starting instance should not be found")
+	}
+	instances.release("b", nil)
+
+	// a failed start gives the name back
+	failStart = true
+	if _, err := CreateInstance(&pb.CreateInstanceRequest{Name: "c", Content: content}); err == nil {
+		t.Error("expected error for a failed start")
+	}
+	failStart = false
+	if _, err := CreateInstance(&pb.CreateInstanceRequest{Name: "c", Content: content}); err != nil {
+		t.Errorf("name of a failed start should be free: %v", err)
+	}
+
+	list, err := ListInstances()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 || list.Items[0].Name != "a" || list.Items[1].Name != "c" {
+		t.Errorf("unexpected instances: %+v", list.Items)
+	}
+	if resp, err := InspectInstance(&pb.InstanceRequest{Name: "c"}); err != nil || resp.Instance.Name != "c" {
+		t.Errorf("unexpected inspect: %+v %v", resp, err)
+	}
+
+	if _, err := DestroyInstance(&pb.InstanceRequest{Name: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := InspectInstance(&pb.InstanceRequest{Name: "a"}); err == nil {
+		t.Error("destroyed instance should not be found")
+	}
+	if _, err := DestroyInstance(&pb.InstanceRequest{Name: "a"}); err == nil {
+		t.Error("expected error destroying twice")
+	}
+	if list, _ := ListInstances(); len(list.Items) != 1 {
+		t.Errorf("unexpected instances after destroy: %+v", list.Items)
+	}
+}
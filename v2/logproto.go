@@ -2,6 +2,7 @@ package v2
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
@@ -26,6 +27,16 @@ func Log(level pb.LogLevel, typ pb.LogType, message string) {
 	})
 }
 
+// writerLogFunc returns a Log printing to output, for logs that do not
+// belong to the core and its log stream.
+func writerLogFunc(output io.Writer) func(level pb.LogLevel, typ pb.LogType, message string) {
+	return func(level pb.LogLevel, typ pb.LogType, message string) {
+		if level != pb.LogLevel_DEBUG {
+			fmt.Fprintf(output, "%s %s %s\n", level, typ, message)
+		}
+	}
+}
+
 func (s *CoreService) LogListener(req *pb.Empty, stream grpc.ServerStreamingServer[pb.LogMessage]) error {
 	logSub, stopch, _ := logObserver.Subscribe()
 	defer logObserver.UnSubscribe(logSub)
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"errors"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+)
+
+// parseAttemptsToPb lists why each parser rejected the config, or nil when
+// err is not a *config.ParseError.
+func parseAttemptsToPb(err error) []*pb.ParseAttempt {
+	var parseErr *config.ParseError
+	if !errors.As(err, &parseErr) {
+		return nil
+	}
+	attempts := make([]*pb.ParseAttempt, 0, len(parseErr.Attempts))
+	for _, attempt := range parseErr.Attempts {
+		attempts = append(attempts, &pb.ParseAttempt{
+			Parser:    attempt.Parser,
+			Message:   attempt.Err.Error(),
+			Line:      int32(attempt.Line),
+			LinkIndex: int32(attempt.LinkIndex),
+			LinkCount: int32(attempt.LinkCount),
+		})
+	}
+	return attempts
+}
+
+func skippedEntriesToPb(report *config.ParseReport) []*pb.SkippedEntry {
+	if report == nil {
+		return nil
+	}
+	skipped := make([]*pb.SkippedEntry, 0, len(report.Skipped))
+	for _, entry := range report.Skipped {
+		skipped = append(skipped, &pb.SkippedEntry{
+			Index:   int32(entry.Index),
+			Line:    int32(entry.Line),
+			Tag:     entry.Tag,
+			Parser:  entry.Parser,
+			Message: entry.Err.Error(),
+		})
+	}
+	return skipped
+}
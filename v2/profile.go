@@ -0,0 +1,448 @@
+package v2
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+	"github.com/hiddify/hiddify-core/v2/service_manager"
+)
+
+const profileUpdateCheckInterval = 5 * time.Minute
+
+// profilesAccess serializes the changes to the profile table, so that a
+// refresh does not write back the active flag of a stale copy.
+var profilesAccess sync.Mutex
+
+type Profile struct {
+	Id             string
+	Name           string
+	Url            string
+	Headers        map[string]string
+	Content        string
+	ETag           string
+	LastFetch      time.Time
+	LastError      string
+	UpdateInterval int // hours, from profile-update-interval
+	Active         bool
+	Info           *config.SubscriptionInfo
+}
+
+func (p *Profile) toPb() *pb.Profile {
+	var lastFetch int64
+	if !p.LastFetch.IsZero() {
+		lastFetch = p.LastFetch.Unix()
+	}
+	return &pb.Profile{
+		Id:               p.Id,
+		Name:             p.Name,
+		Url:              p.Url,
+		Headers:          p.Headers,
+		LastFetch:        lastFetch,
+		Etag:             p.ETag,
+		UpdateInterval:   int32(p.UpdateInterval),
+		Active:           p.Active,
+		LastError:        p.LastError,
+		SubscriptionInfo: subscriptionInfoToPb(p.Info),
+	}
+}
+
+func (p *Profile) isDue(now time.Time) bool {
+	if p.Url == "" || p.UpdateInterval <= 0 {
+		return false
+	}
+	return now.Sub(p.LastFetch) >= time.Duration(p.UpdateInterval)*time.Hour
+}
+
+func newProfileId() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprint(time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// fetchProfile downloads the profile and reports whether its content changed.
+// The previous content is kept when the new one can not be parsed.
+func fetchProfile(p *Profile) (bool, error) {
+	p.LastFetch = time.Now()
+	sub, err := fetchSubscription(p.Url, p.Headers, p.ETag)
+	if err != nil {
+		p.LastError = err.Error()
+		return false, err
+	}
+	if sub.RefreshInterval > 0 {
+		p.UpdateInterval = sub.RefreshInterval
+	}
+	if sub.Info != nil {
+		p.Info = sub.Info
+	}
+	if sub.NotModified {
+		p.LastError = ""
+		return false, nil
+	}
+	if _, err := parseProfileContent(p.Name, sub.Content, false); err != nil {
+		p.LastError = err.Error()
+		return false, err
+	}
+	changed := sub.Content != p.Content
+	p.Content = sub.Content
+	p.ETag = sub.ETag
+	p.LastError = ""
+	return changed, nil
+}
+
+func getProfile(id string) (*Profile, error) {
+	profile, err := db.GetTable[Profile]().Get(id)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, fmt.Errorf("profile %s not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil || profile.Id == "" {
+		return nil, fmt.Errorf("profile %s not found", id)
+	}
+	return profile, nil
+}
+
+func ActiveProfile() (*Profile, error) {
+	profiles, err := db.GetTable[Profile]().All()
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		if profile.Active {
+			return profile, nil
+		}
+	}
+	return nil, fmt.Errorf("no active profile")
+}
+
+// activeProfileConfig parses the active profile into sing-box outbounds, ready for StartService.
+func activeProfileConfig() (string, error) {
+	profile, err := ActiveProfile()
+	if err != nil {
+		return "", err
+	}
+	return profileConfig(profile)
+}
+
+func profileConfig(profile *Profile) (string, error) {
+	content, err := parseProfileContent(profile.Name, profile.Content, true)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// parseProfileContent parses leniently so that a single broken link does not
+// make the whole profile unusable.
+func parseProfileContent(name string, content string, debug bool) ([]byte, error) {
+	parsed, report, err := config.ParseConfigContentLenient(content, debug, HiddifyOptions, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range report.Skipped {
+		Log(pb.LogLevel_WARNING, pb.LogType_CONFIG, fmt.Sprintf("profile %s: skipped entry %d (%s): %v", name, entry.Index, entry.Tag, entry.Err))
+	}
+	return parsed, nil
+}
+
+func restartWithProfile(profile *Profile) error {
+	if CoreState != pb.CoreState_STARTED {
+		return nil
+	}
+	content, err := profileConfig(profile)
+	if err != nil {
+		return err
+	}
+	Log(pb.LogLevel_INFO, pb.LogType_CONFIG, "Restarting with profile "+profile.Name)
+	_, err = Restart(&pb.StartRequest{
+		ConfigContent:          content,
+		EnableOldCommandServer: oldCommandServer != nil,
+	})
+	return err
+}
+
+func profileFailed(err error) (*pb.ProfileResponse, error) {
+	return &pb.ProfileResponse{
+		ResponseCode: pb.ResponseCode_FAILED,
+		Message:      err.Error(),
+	}, err
+}
+
+func (s *CoreService) AddProfile(ctx context.Context, in *pb.AddProfileRequest) (*pb.ProfileResponse, error) {
+	return AddProfile(in)
+}
+
+func AddProfile(in *pb.AddProfileRequest) (*pb.ProfileResponse, error) {
+	if in.Url == "" && in.Content == "" {
+		return profileFailed(fmt.Errorf("either url or content is required"))
+	}
+	profile := &Profile{
+		Id:      newProfileId(),
+		Name:    in.Name,
+		Url:     in.Url,
+		Headers: in.Headers,
+		Content: in.Content,
+	}
+	if profile.Url != "" {
+		if _, err := fetchProfile(profile); err != nil {
+			return profileFailed(err)
+		}
+	} else if _, err := parseProfileContent(profile.Name, profile.Content, false); err != nil {
+		return profileFailed(err)
+	} else {
+		profile.Info = config.ParseSubscriptionInfo(nil, profile.Content)
+	}
+	if profile.Name == "" && profile.Info != nil {
+		profile.Name = profile.Info.Title
+	}
+	if profile.Name == "" {
+		profile.Name = profile.Url
+	}
+	profilesAccess.Lock()
+	err := db.GetTable[Profile]().UpdateInsert(profile)
+	profilesAccess.Unlock()
+	if err != nil {
+		return profileFailed(err)
+	}
+	return &pb.ProfileResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Profile:      profile.toPb(),
+	}, nil
+}
+
+func (s *CoreService) ListProfiles(ctx context.Context, empty *pb.Empty) (*pb.ProfileList, error) {
+	return ListProfiles()
+}
+
+func ListProfiles() (*pb.ProfileList, error) {
+	profiles, err := db.GetTable[Profile]().All()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	list := &pb.ProfileList{}
+	for _, profile := range profiles {
+		list.Items = append(list.Items, profile.toPb())
+	}
+	return list, nil
+}
+
+func (s *CoreService) RefreshProfile(ctx context.Context, in *pb.ProfileRequest) (*pb.ProfileResponse, error) {
+	return RefreshProfile(in)
+}
+
+func RefreshProfile(in *pb.ProfileRequest) (*pb.ProfileResponse, error) {
+	profile, err := getProfile(in.Id)
+	if err != nil {
+		return profileFailed(err)
+	}
+	if err := refreshProfile(profile); err != nil {
+		return profileFailed(err)
+	}
+	return &pb.ProfileResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Profile:      profile.toPb(),
+	}, nil
+}
+
+// refreshProfile fetches the profile and stores what the fetch changed on
+// the current record, so the profile may be a stale copy. It is updated to
+// the stored profile.
+func refreshProfile(profile *Profile) error {
+	if profile.Url == "" {
+		return fmt.Errorf("profile %s has no url", profile.Name)
+	}
+	fetched := *profile
+	changed, fetchErr := fetchProfile(&fetched)
+
+	profilesAccess.Lock()
+	current, err := getProfile(profile.Id)
+	if err == nil {
+		current.LastFetch = fetched.LastFetch
+		current.LastError = fetched.LastError
+		current.UpdateInterval = fetched.UpdateInterval
+		current.Info = fetched.Info
+		current.Content = fetched.Content
+		current.ETag = fetched.ETag
+		err = db.GetTable[Profile]().UpdateInsert(current)
+	}
+	profilesAccess.Unlock()
+	if err != nil {
+		return err
+	}
+	*profile = *current
+	if fetchErr != nil {
+		return fetchErr
+	}
+	if changed && profile.Active {
+		return restartWithProfile(profile)
+	}
+	return nil
+}
+
+func (s *CoreService) ActivateProfile(ctx context.Context, in *pb.ProfileRequest) (*pb.ProfileResponse, error) {
+	return ActivateProfile(in)
+}
+
+func ActivateProfile(in *pb.ProfileRequest) (*pb.ProfileResponse, error) {
+	active, err := activateProfile(in.Id)
+	if err != nil {
+		return profileFailed(err)
+	}
+	if err := restartWithProfile(active); err != nil {
+		return profileFailed(err)
+	}
+	return &pb.ProfileResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Profile:      active.toPb(),
+	}, nil
+}
+
+func activateProfile(id string) (*Profile, error) {
+	profilesAccess.Lock()
+	defer profilesAccess.Unlock()
+	table := db.GetTable[Profile]()
+	profiles, err := table.All()
+	if err != nil {
+		return nil, err
+	}
+	var active *Profile
+	for _, profile := range profiles {
+		profile.Active = profile.Id == id
+		if profile.Active {
+			active = profile
+		}
+	}
+	if active == nil {
+		return nil, fmt.Errorf("profile %s not found", id)
+	}
+	if err := table.UpdateInsert(profiles...); err != nil {
+		return nil, err
+	}
+	return active, nil
+}
+
+func (s *CoreService) DeleteProfile(ctx context.Context, in *pb.ProfileRequest) (*pb.Response, error) {
+	return DeleteProfile(in)
+}
+
+func DeleteProfile(in *pb.ProfileRequest) (*pb.Response, error) {
+	err := deleteProfile(in.Id)
+	if err != nil {
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
+
+// deleteProfile refuses to delete the active profile, another one has to be
+// activated first.
+func deleteProfile(id string) error {
+	profilesAccess.Lock()
+	defer profilesAccess.Unlock()
+	profile, err := getProfile(id)
+	if err != nil {
+		return err
+	}
+	if profile.Active {
+		return fmt.Errorf("profile %s is active", profile.Name)
+	}
+	return db.GetTable[Profile]().Delete(id)
+}
+
+func (s *CoreService) GetSubscriptionInfo(ctx context.Context, in *pb.ProfileRequest) (*pb.SubscriptionInfoResponse, error) {
+	return GetSubscriptionInfo(in)
+}
+
+// GetSubscriptionInfo returns the quota and expiry of a profile, or of the
+// active one when no id is given.
+func GetSubscriptionInfo(in *pb.ProfileRequest) (*pb.SubscriptionInfoResponse, error) {
+	var profile *Profile
+	var err error
+	if in.Id == "" {
+		profile, err = ActiveProfile()
+	} else {
+		profile, err = getProfile(in.Id)
+	}
+	if err != nil {
+		return &pb.SubscriptionInfoResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.SubscriptionInfoResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Info:         subscriptionInfoToPb(profile.Info),
+	}, nil
+}
+
+func refreshDueProfiles() {
+	profiles, err := db.GetTable[Profile]().All()
+	if err != nil {
+		Log(pb.LogLevel_WARNING, pb.LogType_CONFIG, "failed to load profiles: "+err.Error())
+		return
+	}
+	now := time.Now()
+	for _, profile := range profiles {
+		if !profile.isDue(now) {
+			continue
+		}
+		if err := refreshProfile(profile); err != nil {
+			Log(pb.LogLevel_WARNING, pb.LogType_CONFIG, fmt.Sprintf("failed to refresh profile %s: %v", profile.Name, err))
+		}
+	}
+}
+
+type profileUpdater struct {
+	done chan struct{}
+}
+
+func (u *profileUpdater) Start() error {
+	u.done = make(chan struct{})
+	go u.loop(u.done)
+	return nil
+}
+
+func (u *profileUpdater) Close() error {
+	if u.done != nil {
+		close(u.done)
+		u.done = nil
+	}
+	return nil
+}
+
+func (u *profileUpdater) loop(done chan struct{}) {
+	ticker := time.NewTicker(profileUpdateCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			refreshDueProfiles()
+		}
+	}
+}
+
+func init() {
+	service_manager.Register(&profileUpdater{})
+}
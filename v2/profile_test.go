@@ -0,0 +1,178 @@
+package v2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+)
+
+const testProfileContent = `{"outbounds":[{"type":"socks","tag":"a","server":"127.0.0.1","server_port":1080}]}`
+
+func TestProfileIsDue(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		profile Profile
+		due     bool
+	}{
+		{"no url", Profile{UpdateInterval: 1, LastFetch: now.Add(-2 * time.Hour)}, false},
+		{"no interval", Profile{Url: "https://example.com", LastFetch: now.Add(-2 * time.Hour)}, false},
+		{"never fetched", Profile{Url: "https://example.com", UpdateInterval: 1}, true},
+		{"fresh", Profile{Url: "https://example.com", UpdateInterval: 1, LastFetch: now.Add(-30 * time.Minute)}, false},
+		{"stale", Profile{Url: "https://example.com", UpdateInterval: 1, LastFetch: now.Add(-time.Hour)}, true},
+	}
+	for _, test := range tests {
+		if due := test.profile.isDue(now); due != test.due {
+			t.Errorf("%s: isDue = %v, want %v", test.name, due, test.due)
+		}
+	}
+}
+
+func TestProfileManager(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	if _, err := getProfile("missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := AddProfile(&pb.AddProfileRequest{}); err == nil {
+		t.Error("expected a profile without url or content to be rejected")
+	}
+
+	first, err := AddProfile(&pb.AddProfileRequest{Name: "first", Content: testProfileContent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := AddProfile(&pb.AddProfileRequest{Name: "second", Content: testProfileContent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := ListProfiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 || list.Items[0].Name != "first" || list.Items[1].Name != "second" {
+		t.Fatalf("unexpected profiles: %v", list.Items)
+	}
+
+	if _, err := ActivateProfile(&pb.ProfileRequest{Id: second.Profile.Id}); err != nil {
+		t.Fatal(err)
+	}
+	active, err := ActiveProfile()
+	if err != nil || active.Id != second.Profile.Id {
+		t.Fatalf("unexpected active profile: %v %v", active, err)
+	}
+	if _, err := ActivateProfile(&pb.ProfileRequest{Id: "missing"}); err == nil {
+		t.Error("expected activating a missing profile to fail")
+	}
+
+	if _, err := DeleteProfile(&pb.ProfileRequest{Id: first.Profile.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := getProfile(first.Profile.Id); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected deleted profile to be missing, got %v", err)
+	}
+	if _, err := getProfile(second.Profile.Id); err != nil {
+		t.Error(err)
+	}
+	if _, err := DeleteProfile(&pb.ProfileRequest{Id: first.Profile.Id}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected deleting a missing profile to fail, got %v", err)
+	}
+	if _, err := DeleteProfile(&pb.ProfileRequest{Id: second.Profile.Id}); err == nil {
+		t.Error("expected deleting the active profile to fail")
+	}
+}
+
+func TestRefreshStaleProfile(t *testing.T) {
+	t.Chdir(t.TempDir())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testProfileContent))
+	}))
+	defer server.Close()
+
+	added, err := AddProfile(&pb.AddProfileRequest{Name: "remote", Url: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale, err := getProfile(added.Profile.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ActivateProfile(&pb.ProfileRequest{Id: stale.Id}); err != nil {
+		t.Fatal(err)
+	}
+	// a scheduled refresh still holding the copy from before the activation
+	if err := refreshProfile(stale); err != nil {
+		t.Fatal(err)
+	}
+	if !stale.Active {
+		t.Error("refresh should return the stored profile")
+	}
+	if active, err := ActiveProfile(); err != nil || active.Id != stale.Id {
+		t.Errorf("refresh overwrote the activation: %v %v", active, err)
+	}
+}
+
+func TestRefreshDueProfiles(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	var requests atomic.Int32
+	var content atomic.Value
+	content.Store(testProfileContent)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(content.Load().(string)))
+	}))
+	defer server.Close()
+
+	due, err := AddProfile(&pb.AddProfileRequest{Name: "due", Url: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh, err := AddProfile(&pb.AddProfileRequest{Name: "fresh", Url: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := db.GetTable[Profile]()
+	setFetch := func(id string, lastFetch time.Time) {
+		profile, err := getProfile(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		profile.UpdateInterval = 1
+		profile.LastFetch = lastFetch
+		if err := table.UpdateInsert(profile); err != nil {
+			t.Fatal(err)
+		}
+	}
+	setFetch(due.Profile.Id, time.Now().Add(-2*time.Hour))
+	setFetch(fresh.Profile.Id, time.Now())
+
+	updated := strings.Replace(testProfileContent, `"tag":"a"`, `"tag":"b"`, 1)
+	content.Store(updated)
+	requests.Store(0)
+	refreshDueProfiles()
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected only the due profile to be fetched, got %d requests", n)
+	}
+	profile, err := getProfile(due.Profile.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Content != updated || time.Since(profile.LastFetch) > time.Minute {
+		t.Errorf("due profile was not refreshed: %+v", profile)
+	}
+	profile, err = getProfile(fresh.Profile.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Content != testProfileContent {
+		t.Error("fresh profile should not be refreshed")
+	}
+}
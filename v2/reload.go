@@ -0,0 +1,289 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing-box/outbound"
+	"github.com/sagernet/sing-box/route"
+)
+
+func (s *CoreService) ReloadHiddifySettings(ctx context.Context, in *pb.ChangeHiddifySettingsRequest) (*pb.ReloadSettingsResponse, error) {
+	return ReloadHiddifySettings(in)
+}
+
+// ReloadHiddifySettings applies the difference between HiddifyOptions and
+// the new settings to the running core. The log level, rules, url-test
+// interval and selector default are applied live. Inbound and tun changes,
+// and live changes the running config can not take, restart the core with a
+// config built from the new settings. Other changes are used from the next
+// start. The response lists which keys took which path.
+func ReloadHiddifySettings(in *pb.ChangeHiddifySettingsRequest) (*pb.ReloadSettingsResponse, error) {
+	defer config.DeferPanicToError("reload", func(err error) {
+		Log(pb.LogLevel_FATAL, pb.LogType_CONFIG, err.Error())
+		StopAndAlert(pb.MessageType_UNEXPECTED_ERROR, err.Error())
+	})
+	options, err := parseHiddifySettings(in.HiddifySettingsJson)
+	if err != nil {
+		return reloadFailed(err)
+	}
+	changes, err := config.DiffHiddifyOptions(HiddifyOptions, options)
+	if err != nil {
+		return reloadFailed(err)
+	}
+
+	resp := &pb.ReloadSettingsResponse{ResponseCode: pb.ResponseCode_OK}
+	if CoreState != pb.CoreState_STARTED || Box == nil {
+		HiddifyOptions = options
+		for _, change := range changes {
+			resp.Pending = append(resp.Pending, change.Key)
+		}
+		return resp, nil
+	}
+
+	var reasons, live []string
+	for _, change := range changes {
+		switch change.Scope {
+		case config.ChangeScopeRestart:
+			reasons = append(reasons, change.Key)
+		case config.ChangeScopeLive:
+			live = append(live, change.Key)
+		}
+	}
+	if len(reasons) == 0 && len(live) > 0 {
+		if err := applyLiveOptions(live, options); err != nil {
+			Log(pb.LogLevel_WARNING, pb.LogType_CONFIG, fmt.Sprintf("failed to apply %s live: %v", strings.Join(live, ", "), err))
+			reasons = live
+		}
+	}
+	if len(reasons) == 0 {
+		HiddifyOptions = options
+		for _, change := range changes {
+			if change.Scope == config.ChangeScopeLive {
+				resp.AppliedLive = append(resp.AppliedLive, change.Key)
+			} else {
+				resp.Pending = append(resp.Pending, change.Key)
+			}
+		}
+		return resp, nil
+	}
+
+	if activeStartRequest == nil {
+		return reloadFailed(fmt.Errorf("no start request to restart with"))
+	}
+	Log(pb.LogLevel_INFO, pb.LogType_CORE, "Restarting for "+strings.Join(reasons, ", "))
+	// the config is built from HiddifyOptions, which keeps the new settings
+	// only if the core starts with them
+	previous := HiddifyOptions
+	HiddifyOptions = options
+	coreInfo, err := Restart(activeStartRequest)
+	resp.CoreInfo = coreInfo
+	if err != nil {
+		HiddifyOptions = previous
+		resp.ResponseCode = pb.ResponseCode_FAILED
+		resp.Message = err.Error()
+		return resp, err
+	}
+	for _, change := range changes {
+		resp.Restart = append(resp.Restart, change.Key)
+	}
+	resp.Restarted = true
+	return resp, nil
+}
+
+// applyLiveOptions applies live changes to the running core. Every change is
+// prepared before any is applied, so a change the running core can not take
+// leaves it as it was.
+func applyLiveOptions(keys []string, options *config.HiddifyOptions) error {
+	router := Box.GetInstance().Router()
+	var apply []func()
+	var built *option.Options
+	var origins []config.RuleOrigin
+	for _, key := range keys {
+		switch key {
+		case "log-level":
+			level, err := log.ParseLevel(options.LogLevel)
+			if err != nil {
+				return err
+			}
+			if coreLogFactory == nil {
+				return fmt.Errorf("logger is not initialized")
+			}
+			apply = append(apply, func() { coreLogFactory.SetLevel(level) })
+		case "url-test-interval":
+			groupStatesAccess.Lock()
+			groups := coreGroups
+			groupStatesAccess.Unlock()
+			if groups == nil {
+				return fmt.Errorf("groups are not running")
+			}
+			interval := options.URLTestInterval.Duration()
+			apply = append(apply, func() { groups.setURLTestInterval(interval) })
+		case "rules", "selector-default":
+			if built == nil {
+				var err error
+				if built, origins, err = rebuildActiveConfig(options); err != nil {
+					return err
+				}
+			}
+			prepare := replaceRouteRules
+			if key == "selector-default" {
+				prepare = selectDefaultOutbound
+			}
+			change, err := prepare(router, activeBuiltConfig, built)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			apply = append(apply, change)
+		default:
+			return fmt.Errorf("%s can not be changed live", key)
+		}
+	}
+	for _, change := range apply {
+		change()
+	}
+	if built != nil {
+		activeBuiltConfig = built
+		routeRuleOrigins = origins
+		if server, err := coreRoutedServer(); err == nil {
+			server.setOrigins(origins)
+		}
+		config.SaveCurrentConfig(filepath.Join(sWorkingPath, "current-config.json"), *built)
+	}
+	return nil
+}
+
+// rebuildActiveConfig builds the config of the running core again from
+// options.
+func rebuildActiveConfig(options *config.HiddifyOptions) (*option.Options, []config.RuleOrigin, error) {
+	if activeBuiltConfig == nil {
+		return nil, nil, fmt.Errorf("the running config is not built from the settings")
+	}
+	parsed, err := readOptions(activeConfigContent)
+	if err != nil {
+		return nil, nil, err
+	}
+	buildOptions := *options
+	ruleSets.prepare(&buildOptions)
+	return config.BuildConfigWithRuleOrigins(buildOptions, parsed)
+}
+
+// replaceRouteRules prepares putting the route rules of built in place of
+// the ones of the router. The router keeps the list it was started with, so
+// the rules can only be replaced one by one, and only when the dns rules and
+// rule-sets they need did not change.
+func replaceRouteRules(router adapter.Router, running *option.Options, built *option.Options) (func(), error) {
+	if !sameConfigExceptRouteRules(running, built) {
+		return nil, fmt.Errorf("the dns rules or rule-sets changed")
+	}
+	current := router.Rules()
+	if built.Route == nil || len(built.Route.Rules) != len(current) {
+		return nil, fmt.Errorf("the number of route rules changed")
+	}
+	if routeRulesNeedProcess(built.Route.Rules) && !routeRulesNeedProcess(running.Route.Rules) {
+		return nil, fmt.Errorf("process rules need the process searcher of a new core")
+	}
+	if coreLogFactory == nil {
+		return nil, fmt.Errorf("logger is not initialized")
+	}
+	logger := coreLogFactory.NewLogger("router")
+	rules := make([]adapter.Rule, len(current))
+	for i, ruleOptions := range built.Route.Rules {
+		rule, err := route.NewRule(router, logger, ruleOptions, true)
+		if err != nil {
+			return nil, fmt.Errorf("rule[%d]: %w", i, err)
+		}
+		if _, ok := router.Outbound(rule.Outbound()); !ok {
+			return nil, fmt.Errorf("rule[%d]: outbound %s not found", i, rule.Outbound())
+		}
+		if err := rule.Start(); err != nil {
+			return nil, fmt.Errorf("rule[%d]: %w", i, err)
+		}
+		rules[i] = rule
+	}
+	// the old rules hold nothing that needs closing and may still be
+	// matched by connections being routed
+	return func() { copy(current, rules) }, nil
+}
+
+// sameConfigExceptRouteRules reports whether the dns and route options of
+// two configs only differ in their route rules.
+func sameConfigExceptRouteRules(a *option.Options, b *option.Options) bool {
+	routing := func(options *option.Options) ([]byte, error) {
+		var route option.RouteOptions
+		if options.Route != nil {
+			route = *options.Route
+		}
+		route.Rules = nil
+		return json.Marshal(struct {
+			DNS   *option.DNSOptions
+			Route option.RouteOptions
+		}{options.DNS, route})
+	}
+	contentA, errA := routing(a)
+	contentB, errB := routing(b)
+	return errA == nil && errB == nil && string(contentA) == string(contentB)
+}
+
+func routeRulesNeedProcess(rules []option.Rule) bool {
+	needsProcess := func(rule option.DefaultRule) bool {
+		return len(rule.ProcessName) > 0 || len(rule.ProcessPath) > 0 || len(rule.PackageName) > 0
+	}
+	for _, rule := range rules {
+		if rule.Type == C.RuleTypeLogical {
+			for _, subRule := range rule.LogicalOptions.Rules {
+				if needsProcess(subRule) {
+					return true
+				}
+			}
+		} else if needsProcess(rule.DefaultOptions) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectDefaultOutbound prepares selecting the default of the select group
+// of built.
+func selectDefaultOutbound(router adapter.Router, running *option.Options, built *option.Options) (func(), error) {
+	var target string
+	for _, out := range built.Outbounds {
+		if out.Tag == config.OutboundSelectTag {
+			target = out.SelectorOptions.Default
+		}
+	}
+	out, ok := router.Outbound(config.OutboundSelectTag)
+	if !ok {
+		return nil, fmt.Errorf("%s group not found", config.OutboundSelectTag)
+	}
+	selector, ok := out.(*outbound.Selector)
+	if !ok || target == "" || !containsTag(selector.All(), target) {
+		return nil, fmt.Errorf("%s can not be selected", target)
+	}
+	return func() { selector.SelectOutbound(target) }, nil
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, current := range tags {
+		if current == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func reloadFailed(err error) (*pb.ReloadSettingsResponse, error) {
+	return &pb.ReloadSettingsResponse{
+		ResponseCode: pb.ResponseCode_FAILED,
+		Message:      err.Error(),
+	}, err
+}
@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+)
+
+func (s *CoreService) ExplainRoute(ctx context.Context, in *pb.RouteExplainRequest) (*pb.RouteExplainResponse, error) {
+	return ExplainRoute(in)
+}
+
+// ExplainRoute evaluates a connection against the config built from the
+// current settings and a profile, without resolving or downloading anything.
+func ExplainRoute(in *pb.RouteExplainRequest) (*pb.RouteExplainResponse, error) {
+	name := "content"
+	content := in.Content
+	if content == "" {
+		var profile *Profile
+		var err error
+		if in.ProfileId == "" {
+			profile, err = ActiveProfile()
+		} else {
+			profile, err = getProfile(in.ProfileId)
+		}
+		if err != nil {
+			return explainRouteFailed(err)
+		}
+		name, content = profile.Name, profile.Content
+	}
+	parsed, err := parseProfileContent(name, content, false)
+	if err != nil {
+		return explainRouteFailed(err)
+	}
+	input, err := readOptions(string(parsed))
+	if err != nil {
+		return explainRouteFailed(err)
+	}
+	if in.Port > 0xffff {
+		return explainRouteFailed(fmt.Errorf("invalid port %d", in.Port))
+	}
+	opt := *ruleSetOptions()
+	ruleSets.prepare(&opt)
+	explanation, err := config.ExplainRoute(opt, input, config.RouteRequest{
+		Domain:      in.Domain,
+		IP:          in.Ip,
+		Port:        uint16(in.Port),
+		Network:     in.Network,
+		Protocol:    in.Protocol,
+		Inbound:     in.Inbound,
+		SourceIP:    in.SourceIp,
+		Process:     in.Process,
+		PackageName: in.PackageName,
+	})
+	if err != nil {
+		return explainRouteFailed(err)
+	}
+	return &pb.RouteExplainResponse{
+		ResponseCode:  pb.ResponseCode_OK,
+		DnsServer:     explanation.DNSServer,
+		DnsRule:       matchedRouteRule(explanation.DNSRule),
+		RouteRule:     matchedRouteRule(explanation.RouteRule),
+		Outbound:      explanation.Outbound,
+		OutboundChain: explanation.OutboundChain,
+		OutboundType:  explanation.OutboundType,
+		Warnings:      explanation.Warnings,
+	}, nil
+}
+
+func matchedRouteRule(rule *config.MatchedRule) *pb.MatchedRouteRule {
+	if rule == nil {
+		return nil
+	}
+	return &pb.MatchedRouteRule{
+		Index:       int32(rule.Index),
+		Origin:      rule.Origin.Kind,
+		Description: rule.Origin.Description,
+		RuleIndex:   int32(rule.Origin.Index),
+		Rule:        rule.Rule,
+	}
+}
+
+func explainRouteFailed(err error) (*pb.RouteExplainResponse, error) {
+	return &pb.RouteExplainResponse{
+		ResponseCode: pb.ResponseCode_FAILED,
+		Message:      err.Error(),
+	}, err
+}
@@ -0,0 +1,233 @@
+package v2
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/hiddify/hiddify-core/config"
+
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/common/urltest"
+	"github.com/sagernet/sing-box/experimental/clashapi/trafficontrol"
+	N "github.com/sagernet/sing/common/network"
+)
+
+// routeRuleOrigins are the origins of the route rules of the running core,
+// nil for a raw config.
+var routeRuleOrigins []config.RuleOrigin
+
+// routedServer wraps the clash server of the router to see every connection
+// as it is routed: it is tracked by a traffic manager of its own, so that
+// the connection list and the traffic accounting work with the clash api
+// disabled too. The traffic
+// accounting gets the final counts of a connection when it closes.
+type routedServer struct {
+	adapter.ClashServer // nil when the clash api is disabled
+	router              adapter.Router
+	accounting          *trafficAccounting // nil for instances
+	traffic             *trafficontrol.Manager
+	// origins name the traffic of the route rules, see ruleKey
+	origins       []config.RuleOrigin
+	originsAccess sync.RWMutex
+	// routes holds the routeInfo of each tracked connection by id
+	routes sync.Map
+}
+
+// routeInfo is what the clash tracker does not keep about a connection.
+type routeInfo struct {
+	user string
+	rule string
+}
+
+// installRoutedServer puts a routedServer in front of the clash server of
+// the router of the core. accounting may be nil.
+func installRoutedServer(router adapter.Router, accounting *trafficAccounting) *routedServer {
+	server := &routedServer{
+		ClashServer: router.ClashServer(),
+		router:      router,
+		accounting:  accounting,
+		traffic:     trafficontrol.NewManager(),
+		origins:     routeRuleOrigins,
+	}
+	router.SetClashServer(server)
+	return server
+}
+
+// installInstanceServer puts a routedServer with its own traffic manager in
+// front of the router of an instance.
+func installInstanceServer(router adapter.Router) *routedServer {
+	server := &routedServer{router: router, traffic: trafficontrol.NewManager()}
+	router.SetClashServer(server)
+	return server
+}
+
+func (s *routedServer) RoutedConnection(ctx context.Context, conn net.Conn, metadata adapter.InboundContext, matchedRule adapter.Rule) (net.Conn, adapter.Tracker) {
+	var clashTracker adapter.Tracker
+	if s.ClashServer != nil {
+		conn, clashTracker = s.ClashServer.RoutedConnection(ctx, conn, metadata, matchedRule)
+	}
+	tracker := trafficontrol.NewTCPTracker(conn, s.traffic, trackerMetadata(metadata), s.router, matchedRule)
+	return tracker, s.track(tracker, clashTracker, metadata, matchedRule)
+}
+
+func (s *routedServer) RoutedPacketConnection(ctx context.Context, conn N.PacketConn, metadata adapter.InboundContext, matchedRule adapter.Rule) (N.PacketConn, adapter.Tracker) {
+	var clashTracker adapter.Tracker
+	if s.ClashServer != nil {
+		conn, clashTracker = s.ClashServer.RoutedPacketConnection(ctx, conn, metadata, matchedRule)
+	}
+	tracker := trafficontrol.NewUDPTracker(conn, s.traffic, trackerMetadata(metadata), s.router, matchedRule)
+	return tracker, s.track(tracker, clashTracker, metadata, matchedRule)
+}
+
+// trackerMetadata is the metadata the clash api shows for a connection.
+func trackerMetadata(metadata adapter.InboundContext) trafficontrol.Metadata {
+	inbound := metadata.InboundType
+	if metadata.Inbound != "" {
+		inbound += "/" + metadata.Inbound
+	}
+	host := metadata.Domain
+	if host == "" {
+		host = metadata.Destination.Fqdn
+	}
+	var processPath string
+	if metadata.ProcessInfo != nil {
+		processPath = metadata.ProcessInfo.ProcessPath
+		if processPath == "" {
+			processPath = metadata.ProcessInfo.PackageName
+		}
+	}
+	return trafficontrol.Metadata{
+		NetWork:     metadata.Network,
+		Type:        inbound,
+		SrcIP:       metadata.Source.Addr,
+		DstIP:       metadata.Destination.Addr,
+		SrcPort:     strconv.Itoa(int(metadata.Source.Port)),
+		DstPort:     strconv.Itoa(int(metadata.Destination.Port)),
+		Host:        host,
+		DNSMode:     "normal",
+		ProcessPath: processPath,
+	}
+}
+
+type managerTracker interface {
+	adapter.Tracker
+	ID() string
+}
+
+func (s *routedServer) track(tracker managerTracker, clashTracker adapter.Tracker, metadata adapter.InboundContext, matchedRule adapter.Rule) adapter.Tracker {
+	s.routes.Store(tracker.ID(), routeInfo{user: metadata.User, rule: s.ruleKey(matchedRule)})
+	return &routedTracker{Tracker: tracker, id: tracker.ID(), clashTracker: clashTracker, server: s}
+}
+
+// ruleKey names the traffic of rule by its origin, which stays the same
+// when the rule is rebuilt, or by its index for a raw config.
+func (s *routedServer) ruleKey(rule adapter.Rule) string {
+	if rule == nil {
+		return ""
+	}
+	for i, current := range s.router.Rules() {
+		if current != rule {
+			continue
+		}
+		s.originsAccess.RLock()
+		defer s.originsAccess.RUnlock()
+		if i < len(s.origins) {
+			return s.origins[i].TrafficKey(i)
+		}
+		return config.RuleOrigin{}.TrafficKey(i)
+	}
+	return rule.String()
+}
+
+// setOrigins names the traffic of route rules replaced while running.
+func (s *routedServer) setOrigins(origins []config.RuleOrigin) {
+	s.originsAccess.Lock()
+	defer s.originsAccess.Unlock()
+	s.origins = origins
+}
+
+// connections lists the tracked connections.
+func (s *routedServer) connections() []trackerInfo {
+	infos := managerConnections(s.traffic)
+	for i := range infos {
+		s.fill(&infos[i])
+	}
+	return infos
+}
+
+func (s *routedServer) fill(info *trackerInfo) {
+	if route, ok := s.routes.Load(info.Id); ok {
+		info.User = route.(routeInfo).user
+		info.RuleKey = route.(routeInfo).rule
+	}
+}
+
+// The box starts and closes the clash server it created itself; the rest is
+// only called by the router.
+
+func (s *routedServer) Start() error {
+	if s.ClashServer == nil {
+		return nil
+	}
+	return s.ClashServer.Start()
+}
+
+func (s *routedServer) PreStart() error {
+	if s.ClashServer == nil {
+		return nil
+	}
+	return s.ClashServer.PreStart()
+}
+
+func (s *routedServer) Close() error {
+	if s.ClashServer == nil {
+		return nil
+	}
+	return s.ClashServer.Close()
+}
+
+func (s *routedServer) Mode() string {
+	if s.ClashServer == nil {
+		return ""
+	}
+	return s.ClashServer.Mode()
+}
+
+func (s *routedServer) ModeList() []string {
+	if s.ClashServer == nil {
+		return nil
+	}
+	return s.ClashServer.ModeList()
+}
+
+func (s *routedServer) HistoryStorage() *urltest.HistoryStorage {
+	if s.ClashServer == nil {
+		return nil
+	}
+	return s.ClashServer.HistoryStorage()
+}
+
+// routedTracker leaves the clash tracker too and hands the final counts of
+// the connection to the traffic accounting.
+type routedTracker struct {
+	adapter.Tracker
+	id           string
+	clashTracker adapter.Tracker
+	server       *routedServer
+}
+
+func (t *routedTracker) Leave() {
+	t.Tracker.Leave()
+	if t.clashTracker != nil {
+		t.clashTracker.Leave()
+	}
+	if t.server.accounting != nil {
+		if info, ok := trackerInfoOf(t.Tracker); ok {
+			t.server.fill(&info)
+			t.server.accounting.closed(info)
+		}
+	}
+	t.server.routes.Delete(t.id)
+}
@@ -0,0 +1,477 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+	"github.com/hiddify/hiddify-core/v2/service_manager"
+	C "github.com/sagernet/sing-box/constant"
+	M "github.com/sagernet/sing/common/metadata"
+)
+
+const (
+	ruleSetCheckInterval   = time.Hour
+	ruleSetStartDelay      = 30 * time.Second
+	ruleSetDownloadTimeout = 2 * time.Minute
+	ruleSetMaxSize         = 64 << 20
+)
+
+// RuleSet is a remote rule-set cached in the rule-set dir.
+type RuleSet struct {
+	Id           string // tag
+	URL          string
+	Format       string
+	FileName     string
+	ETag         string
+	LastModified string
+	Version      string // sha256 prefix of the file
+	Size         int64
+	UpdatedAt    time.Time
+	CheckedAt    time.Time
+	LastError    string
+}
+
+// ruleSetManager keeps the remote rule-sets of the settings cached in the
+// rule-set dir and serves the dir on a loopback address. The config points
+// sing-box at that server for every cached rule-set, so a rule-set the
+// manager updates is picked up by the running instance on its next check.
+type ruleSetManager struct {
+	done     chan struct{}
+	server   *http.Server
+	listener net.Listener
+	// updating keeps scheduled and requested updates from running together
+	updating sync.Mutex
+}
+
+func (m *ruleSetManager) Start() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	m.listener = listener
+	m.server = &http.Server{Handler: m, ReadHeaderTimeout: 10 * time.Second}
+	go m.server.Serve(listener)
+	m.done = make(chan struct{})
+	go m.loop(m.done)
+	return nil
+}
+
+func (m *ruleSetManager) Close() error {
+	if m.done != nil {
+		close(m.done)
+		m.done = nil
+	}
+	if m.server != nil {
+		err := m.server.Close()
+		m.server = nil
+		m.listener = nil
+		return err
+	}
+	return nil
+}
+
+// prepare points opt at the rule-set dir and server of the manager before a
+// config is built from it.
+func (m *ruleSetManager) prepare(opt *config.HiddifyOptions) {
+	opt.RuleSetDir = ruleSetDir(opt)
+	if m.listener != nil {
+		opt.RuleSetServer = "http://" + m.listener.Addr().String()
+	}
+}
+
+func ruleSetOptions() *config.HiddifyOptions {
+	if HiddifyOptions != nil {
+		return HiddifyOptions
+	}
+	return config.DefaultHiddifyOptions()
+}
+
+func ruleSetDir(opt *config.HiddifyOptions) string {
+	dir := opt.RuleSetDir
+	if dir == "" {
+		dir = config.DefaultHiddifyOptions().RuleSetDir
+	}
+	if !filepath.IsAbs(dir) && sWorkingPath != "" {
+		dir = filepath.Join(sWorkingPath, dir)
+	}
+	return dir
+}
+
+func (m *ruleSetManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name := filepath.Base(filepath.Clean("/" + r.URL.Path))
+	file, err := os.Open(filepath.Join(ruleSetDir(ruleSetOptions()), name))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+	stat, err := file.Stat()
+	if err != nil || stat.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+	// sing-box sends the etag back, so an unchanged file is not parsed again
+	w.Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, stat.ModTime().UnixNano(), stat.Size()))
+	http.ServeContent(w, r, name, stat.ModTime(), file)
+}
+
+func (m *ruleSetManager) loop(done chan struct{}) {
+	timer := time.NewTimer(ruleSetStartDelay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-timer.C:
+		}
+		m.updateStale(ruleSetOptions())
+		timer.Reset(ruleSetCheckInterval)
+	}
+}
+
+// updateStale updates the remote rule-sets of opt that were not checked
+// within the update interval or whose url changed.
+func (m *ruleSetManager) updateStale(opt *config.HiddifyOptions) []*RuleSet {
+	interval := opt.RuleSetUpdateInterval.Duration()
+	var stale []config.RuleSetSource
+	for _, source := range config.RuleSetSources(opt) {
+		if !source.IsRemote() {
+			continue
+		}
+		entry, _ := db.GetTable[RuleSet]().Get(source.Tag)
+		if entry == nil || entry.Id == "" || entry.URL != source.URL || time.Since(entry.CheckedAt) >= interval {
+			stale = append(stale, source)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	return m.update(opt, stale, false)
+}
+
+// update downloads sources into the rule-set dir. Without force, a source
+// that did not change since the last download is not downloaded again.
+func (m *ruleSetManager) update(opt *config.HiddifyOptions, sources []config.RuleSetSource, force bool) []*RuleSet {
+	m.updating.Lock()
+	defer m.updating.Unlock()
+	dir := ruleSetDir(opt)
+	client := ruleSetHTTPClient()
+	table := db.GetTable[RuleSet]()
+	var entries []*RuleSet
+	for _, source := range sources {
+		entry, err := table.Get(source.Tag)
+		if err != nil || entry == nil || entry.Id == "" || entry.URL != source.URL {
+			entry = &RuleSet{Id: source.Tag}
+		}
+		entry.URL = source.URL
+		entry.Format = source.Format
+		entry.FileName = source.FileName()
+		if err := downloadRuleSet(client, dir, entry, force); err != nil {
+			entry.LastError = err.Error()
+			Log(pb.LogLevel_WARNING, pb.LogType_CORE, fmt.Sprintf("failed to update rule-set %s: %s", source.Tag, err))
+		} else {
+			entry.LastError = ""
+		}
+		entry.CheckedAt = time.Now()
+		if err := table.UpdateInsert(entry); err != nil {
+			Log(pb.LogLevel_WARNING, pb.LogType_CORE, "failed to store rule-set: "+err.Error())
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// ruleSetHTTPClient dials through the selected outbound of the running
+// instance, so that rule-sets are downloaded where the mirror is blocked,
+// and directly when no instance runs.
+func ruleSetHTTPClient() *http.Client {
+	transport := &http.Transport{
+		ForceAttemptHTTP2:   true,
+		TLSHandshakeTimeout: 30 * time.Second,
+	}
+	if Box != nil {
+		if out, ok := Box.GetInstance().Router().Outbound(config.OutboundSelectTag); ok {
+			transport.DialContext = func(ctx context.Context, network string, address string) (net.Conn, error) {
+				return out.DialContext(ctx, network, M.ParseSocksaddr(address))
+			}
+		}
+	}
+	return &http.Client{Transport: transport, Timeout: ruleSetDownloadTimeout}
+}
+
+func downloadRuleSet(client *http.Client, dir string, entry *RuleSet, force bool) error {
+	path := filepath.Join(dir, entry.FileName)
+	_, statErr := os.Stat(path)
+	request, err := http.NewRequest(http.MethodGet, entry.URL, nil)
+	if err != nil {
+		return err
+	}
+	if !force && statErr == nil {
+		if entry.ETag != "" {
+			request.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			request.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusNotModified:
+		return nil
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("unexpected status %s", response.Status)
+	}
+	content, err := io.ReadAll(io.LimitReader(response.Body, ruleSetMaxSize+1))
+	if err != nil {
+		return err
+	}
+	if len(content) > ruleSetMaxSize {
+		return fmt.Errorf("rule-set is larger than %d bytes", ruleSetMaxSize)
+	}
+	if err := validateRuleSet(entry.Format, content); err != nil {
+		return err
+	}
+	entry.ETag = response.Header.Get("ETag")
+	entry.LastModified = response.Header.Get("Last-Modified")
+	sum := sha256.Sum256(content)
+	version := hex.EncodeToString(sum[:6])
+	if version == entry.Version && statErr == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	// a rule-set is replaced at once, never served half written
+	temp := path + ".tmp"
+	if err := os.WriteFile(temp, content, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(temp, path); err != nil {
+		os.Remove(temp)
+		return err
+	}
+	entry.Version = version
+	entry.Size = int64(len(content))
+	entry.UpdatedAt = time.Now()
+	return nil
+}
+
+func validateRuleSet(format string, content []byte) error {
+	switch format {
+	case C.RuleSetFormatSource:
+		if !json.Valid(content) {
+			return fmt.Errorf("invalid source rule-set")
+		}
+	default:
+		if !bytes.HasPrefix(content, []byte("SRS")) {
+			return fmt.Errorf("invalid binary rule-set")
+		}
+	}
+	return nil
+}
+
+func (s *CoreService) ListRuleSets(ctx context.Context, in *pb.Empty) (*pb.RuleSetList, error) {
+	return ListRuleSets()
+}
+
+// ListRuleSets returns the rule-sets of the current settings, every other
+// cached rule-set and the compiled local rule-sets.
+func ListRuleSets() (*pb.RuleSetList, error) {
+	opt := ruleSetOptions()
+	entries, err := db.GetTable[RuleSet]().All()
+	if err != nil {
+		return &pb.RuleSetList{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+	}
+	return &pb.RuleSetList{ResponseCode: pb.ResponseCode_OK, Items: ruleSetInfos(opt, entries)}, nil
+}
+
+func ruleSetInfos(opt *config.HiddifyOptions, entries []*RuleSet) []*pb.RuleSetInfo {
+	dir := ruleSetDir(opt)
+	stored := make(map[string]*RuleSet, len(entries))
+	for _, entry := range entries {
+		stored[entry.Id] = entry
+	}
+	items := map[string]*pb.RuleSetInfo{}
+	for _, source := range config.RuleSetSources(opt) {
+		item := &pb.RuleSetInfo{Tag: source.Tag, Url: source.URL, Format: source.Format, InUse: true}
+		switch {
+		case !source.IsRemote():
+			item.Source = "local"
+			if stat, err := os.Stat(source.URL); err == nil {
+				item.Size = stat.Size()
+				item.UpdatedAt = stat.ModTime().Unix()
+			} else {
+				item.LastError = err.Error()
+			}
+		case stored[source.Tag] == nil:
+			item.Source = "remote"
+			// files shipped in the rule-set dir are used until the first update
+			if stat, err := os.Stat(filepath.Join(dir, source.FileName())); err == nil {
+				item.Source = "bundled"
+				item.Size = stat.Size()
+				item.UpdatedAt = stat.ModTime().Unix()
+			}
+		}
+		items[source.Tag] = item
+	}
+	// rule-sets compiled by the ruleset command, whether used or not
+	local := *opt
+	local.RuleSetDir = dir
+	compiled, _ := config.LocalRuleSets(&local)
+	for _, source := range compiled {
+		if _, ok := items[source.Tag]; ok {
+			continue
+		}
+		item := &pb.RuleSetInfo{Tag: source.Tag, Url: source.URL, Format: source.Format, Source: "local"}
+		if stat, err := os.Stat(source.URL); err == nil {
+			item.Size = stat.Size()
+			item.UpdatedAt = stat.ModTime().Unix()
+		}
+		items[source.Tag] = item
+	}
+	for _, entry := range entries {
+		item, ok := items[entry.Id]
+		if !ok {
+			item = &pb.RuleSetInfo{Tag: entry.Id, Url: entry.URL, Format: entry.Format}
+			items[entry.Id] = item
+		}
+		if !item.InUse || item.Url == entry.URL {
+			item.Source = "remote"
+			item.Version = entry.Version
+			item.Size = entry.Size
+			item.UpdatedAt = entry.UpdatedAt.Unix()
+			item.CheckedAt = entry.CheckedAt.Unix()
+			item.LastError = entry.LastError
+		}
+	}
+
+	list := make([]*pb.RuleSetInfo, 0, len(items))
+	for _, item := range items {
+		list = append(list, item)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Tag < list[j].Tag })
+	return list
+}
+
+func (s *CoreService) UpdateRuleSets(ctx context.Context, in *pb.RuleSetRequest) (*pb.RuleSetList, error) {
+	return UpdateRuleSets(in)
+}
+
+// UpdateRuleSets downloads the requested remote rule-sets, or all of them
+// when no tag is given, even if they did not change.
+func UpdateRuleSets(in *pb.RuleSetRequest) (*pb.RuleSetList, error) {
+	opt := ruleSetOptions()
+	sources, err := selectRuleSetSources(opt, in.Tags)
+	if err != nil {
+		return &pb.RuleSetList{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+	}
+	ruleSets.update(opt, sources, true)
+	return ListRuleSets()
+}
+
+func selectRuleSetSources(opt *config.HiddifyOptions, tags []string) ([]config.RuleSetSource, error) {
+	known := map[string]config.RuleSetSource{}
+	var remote []config.RuleSetSource
+	for _, source := range config.RuleSetSources(opt) {
+		known[source.Tag] = source
+		if source.IsRemote() {
+			remote = append(remote, source)
+		}
+	}
+	entries, _ := db.GetTable[RuleSet]().All()
+	for _, entry := range entries {
+		if _, ok := known[entry.Id]; !ok {
+			source := config.RuleSetSource{Tag: entry.Id, URL: entry.URL, Format: entry.Format}
+			known[entry.Id] = source
+			remote = append(remote, source)
+		}
+	}
+	if len(tags) == 0 {
+		return remote, nil
+	}
+	sources := make([]config.RuleSetSource, 0, len(tags))
+	for _, tag := range tags {
+		source, ok := known[tag]
+		if !ok {
+			return nil, fmt.Errorf("unknown rule-set %s", tag)
+		}
+		if !source.IsRemote() {
+			return nil, fmt.Errorf("rule-set %s is a local file", tag)
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+func (s *CoreService) RemoveRuleSets(ctx context.Context, in *pb.RuleSetRequest) (*pb.Response, error) {
+	return RemoveRuleSets(in)
+}
+
+// RemoveRuleSets deletes cached rule-sets. A running instance keeps the
+// rule-sets it loaded; they are downloaded again on the next start.
+func RemoveRuleSets(in *pb.RuleSetRequest) (*pb.Response, error) {
+	ruleSets.updating.Lock()
+	defer ruleSets.updating.Unlock()
+	table := db.GetTable[RuleSet]()
+	entries, err := table.All()
+	if err != nil {
+		return &pb.Response{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+	}
+	stored := make(map[string]*RuleSet, len(entries))
+	for _, entry := range entries {
+		stored[entry.Id] = entry
+	}
+	tags := in.Tags
+	if len(tags) == 0 {
+		for tag := range stored {
+			tags = append(tags, tag)
+		}
+	}
+	dir := ruleSetDir(ruleSetOptions())
+	for _, tag := range tags {
+		entry, ok := stored[tag]
+		if !ok {
+			err := fmt.Errorf("rule-set %s is not cached", tag)
+			return &pb.Response{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+		}
+		if err := os.Remove(filepath.Join(dir, entry.FileName)); err != nil && !os.IsNotExist(err) {
+			return &pb.Response{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+		}
+		if err := table.Delete(tag); err != nil {
+			return &pb.Response{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+		}
+	}
+	return &pb.Response{ResponseCode: pb.ResponseCode_OK}, nil
+}
+
+var ruleSets *ruleSetManager
+
+func init() {
+	ruleSets = &ruleSetManager{}
+	service_manager.Register(ruleSets)
+}
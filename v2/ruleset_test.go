@@ -0,0 +1,283 @@
+package v2
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+var testRuleSetContent = []byte("SRS\x01test")
+
+// testRuleSetServer serves a binary rule-set with an etag and counts the
+// requests it answered.
+type testRuleSetServer struct {
+	*httptest.Server
+	access      sync.Mutex
+	content     []byte
+	etag        string
+	downloads   int
+	notModified int
+}
+
+func newTestRuleSetServer(t *testing.T) *testRuleSetServer {
+	server := &testRuleSetServer{content: testRuleSetContent, etag: `"v1"`}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.access.Lock()
+		defer server.access.Unlock()
+		if r.Header.Get("If-None-Match") == server.etag {
+			server.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		server.downloads++
+		w.Header().Set("ETag", server.etag)
+		w.Write(server.content)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *testRuleSetServer) counts() (int, int) {
+	s.access.Lock()
+	defer s.access.Unlock()
+	return s.downloads, s.notModified
+}
+
+// testRuleSetOptions makes the settings of the tests use a single remote
+// rule-set at url, cached in a temporary dir.
+func testRuleSetOptions(t *testing.T, url string) (*config.HiddifyOptions, config.RuleSetSource) {
+	t.Chdir(t.TempDir())
+	opt := config.DefaultHiddifyOptions()
+	opt.Region = "other"
+	opt.BlockAds = false
+	opt.RuleSetDir = t.TempDir()
+	opt.Rules = []config.Rule{{RuleSetUrl: url + "/test.srs", Outbound: "direct"}}
+	previous := HiddifyOptions
+	HiddifyOptions = opt
+	t.Cleanup(func() { HiddifyOptions = previous })
+	sources := config.RuleSetSources(opt)
+	if len(sources) != 1 {
+		t.Fatalf("expected one rule-set, got %v", sources)
+	}
+	return opt, sources[0]
+}
+
+func TestRuleSetUpdate(t *testing.T) {
+	server := newTestRuleSetServer(t)
+	opt, source := testRuleSetOptions(t, server.URL)
+	manager := &ruleSetManager{}
+	path := filepath.Join(opt.RuleSetDir, source.FileName())
+
+	entries := manager.update(opt, []config.RuleSetSource{source}, false)
+	if len(entries) != 1 || entries[0].LastError != "" {
+		t.Fatalf("unexpected update: %+v", entries)
+	}
+	first := entries[0]
+	if first.ETag != `"v1"` || first.Version == "" || first.Size != int64(len(testRuleSetContent)) {
+		t.Errorf("unexpected entry: %+v", first)
+	}
+	if content, err := os.ReadFile(path); err != nil || !bytes.Equal(content, testRuleSetContent) {
+		t.Fatalf("rule-set is not cached: %q %v", content, err)
+	}
+
+	// the stored etag is sent back and the file is kept
+	entries = manager.update(opt, []config.RuleSetSource{source}, false)
+	if downloads, notModified := server.counts(); downloads != 1 || notModified != 1 {
+		t.Errorf("expected a conditional request, got %d downloads and %d not modified", downloads, notModified)
+	}
+	if entries[0].Version != first.Version || !entries[0].UpdatedAt.Equal(first.UpdatedAt) || !entries[0].CheckedAt.After(first.CheckedAt) {
+		t.Errorf("an unchanged rule-set should only be checked: %+v", entries[0])
+	}
+
+	// a forced update downloads it again
+	manager.update(opt, []config.RuleSetSource{source}, true)
+	if downloads, notModified := server.counts(); downloads != 2 || notModified != 1 {
+		t.Errorf("expected a download, got %d downloads and %d not modified", downloads, notModified)
+	}
+
+	// an invalid download keeps the cached file
+	server.access.Lock()
+	server.content, server.etag = []byte("not a rule-set"), `"v2"`
+	server.access.Unlock()
+	entries = manager.update(opt, []config.RuleSetSource{source}, false)
+	if entries[0].LastError == "" {
+		t.Error("invalid rule-set was accepted")
+	}
+	if content, _ := os.ReadFile(path); !bytes.Equal(content, testRuleSetContent) {
+		t.Errorf("cached rule-set was replaced: %q", content)
+	}
+	stored, err := db.GetTable[RuleSet]().Get(source.Tag)
+	if err != nil || stored.LastError == "" || stored.ETag != `"v1"` {
+		t.Errorf("unexpected stored entry: %+v %v", stored, err)
+	}
+}
+
+func TestRuleSetUpdateStale(t *testing.T) {
+	server := newTestRuleSetServer(t)
+	opt, source := testRuleSetOptions(t, server.URL)
+	manager := &ruleSetManager{}
+
+	if entries := manager.updateStale(opt); len(entries) != 1 || entries[0].Id != source.Tag {
+		t.Fatalf("a new rule-set should be downloaded: %+v", entries)
+	}
+	if entries := manager.updateStale(opt); len(entries) != 0 {
+		t.Errorf("a rule-set checked within the interval was updated: %+v", entries)
+	}
+
+	table := db.GetTable[RuleSet]()
+	entry, err := table.Get(source.Tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry.CheckedAt = time.Now().Add(-opt.RuleSetUpdateInterval.Duration())
+	if err := table.UpdateInsert(entry); err != nil {
+		t.Fatal(err)
+	}
+	if entries := manager.updateStale(opt); len(entries) != 1 {
+		t.Errorf("a rule-set past the interval should be checked: %+v", entries)
+	}
+	if downloads, notModified := server.counts(); downloads != 1 || notModified != 1 {
+		t.Errorf("expected one download and one check, got %d and %d", downloads, notModified)
+	}
+}
+
+func TestRuleSetUpdateThroughProxy(t *testing.T) {
+	server := newTestRuleSetServer(t)
+	opt, source := testRuleSetOptions(t, server.URL)
+
+	var access sync.Mutex
+	var connected []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "connect only", http.StatusMethodNotAllowed)
+			return
+		}
+		access.Lock()
+		connected = append(connected, r.Host)
+		access.Unlock()
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer upstream.Close()
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+		go io.Copy(upstream, conn)
+		io.Copy(conn, upstream)
+	}))
+	defer proxy.Close()
+	port := proxy.Listener.Addr().(*net.TCPAddr).Port
+	service, err := RunEmbeddedInstance(config.DefaultHiddifyOptions(), &option.Options{
+		Outbounds: []option.Outbound{{
+			Type: C.TypeHTTP,
+			Tag:  "proxy",
+			HTTPOptions: option.HTTPOutboundOptions{
+				ServerOptions: option.ServerOptions{Server: "127.0.0.1", ServerPort: uint16(port)},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer service.Close()
+	previous := Box
+	Box = service.libbox
+	defer func() { Box = previous }()
+
+	entries := (&ruleSetManager{}).update(opt, []config.RuleSetSource{source}, true)
+	if len(entries) != 1 || entries[0].LastError != "" {
+		t.Fatalf("unexpected update: %+v", entries)
+	}
+	access.Lock()
+	defer access.Unlock()
+	for _, host := range connected {
+		if host == server.Listener.Addr().String() {
+			return
+		}
+	}
+	t.Errorf("rule-set was not downloaded through the selected outbound: %v", connected)
+}
+
+func TestRemoveRuleSets(t *testing.T) {
+	server := newTestRuleSetServer(t)
+	opt, source := testRuleSetOptions(t, server.URL)
+	ruleSets.update(opt, []config.RuleSetSource{source}, false)
+	path := filepath.Join(opt.RuleSetDir, source.FileName())
+
+	if response, err := RemoveRuleSets(&pb.RuleSetRequest{Tags: []string{"missing"}}); err == nil || response.ResponseCode != pb.ResponseCode_FAILED {
+		t.Errorf("removing a missing rule-set should fail: %v", response)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("rule-set was removed by a failed request: %v", err)
+	}
+
+	if _, err := RemoveRuleSets(&pb.RuleSetRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("rule-set file is still cached: %v", err)
+	}
+	if entries, _ := db.GetTable[RuleSet]().All(); len(entries) != 0 {
+		t.Errorf("rule-set is still stored: %+v", entries)
+	}
+}
+
+func TestRuleSetServer(t *testing.T) {
+	opt, _ := testRuleSetOptions(t, "https://example.com")
+	if err := os.WriteFile(filepath.Join(opt.RuleSetDir, "test.srs"), testRuleSetContent, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	manager := &ruleSetManager{}
+	if err := manager.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Close()
+	prepared := *opt
+	manager.prepare(&prepared)
+	if prepared.RuleSetServer == "" || prepared.RuleSetDir != opt.RuleSetDir {
+		t.Fatalf("unexpected prepared options: %q %q", prepared.RuleSetServer, prepared.RuleSetDir)
+	}
+
+	response, err := http.Get(prepared.RuleSetServer + "/test.srs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+	etag := response.Header.Get("ETag")
+	if response.StatusCode != http.StatusOK || !bytes.Equal(content, testRuleSetContent) || etag == "" {
+		t.Fatalf("unexpected response: %s %q %q", response.Status, content, etag)
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, prepared.RuleSetServer+"/test.srs", nil)
+	request.Header.Set("If-None-Match", etag)
+	if response, err := http.DefaultClient.Do(request); err != nil || response.StatusCode != http.StatusNotModified {
+		t.Errorf("an unchanged rule-set should not be sent again: %v %v", response, err)
+	}
+	for _, path := range []string{"/missing.srs", "/../test.srs/.."} {
+		if response, err := http.Get(prepared.RuleSetServer + path); err != nil || response.StatusCode != http.StatusNotFound {
+			t.Errorf("%s: expected not found: %v %v", path, response, err)
+		}
+	}
+	if response, err := http.Post(prepared.RuleSetServer+"/test.srs", "text/plain", nil); err != nil || response.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected method not allowed: %v %v", response, err)
+	}
+}
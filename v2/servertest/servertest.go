@@ -0,0 +1,277 @@
+// Package servertest measures servers through the dialers of their outbounds.
+// It has no dependency on the core, so it runs against local stand-in servers
+// as well as against outbounds of an instance.
+package servertest
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultURL           = "https://cp.cloudflare.com"
+	DefaultDownloadURL   = "https://speed.cloudflare.com/__down?bytes=10000000"
+	DefaultDownloadLimit = 10_000_000
+	DefaultTimeout       = 15 * time.Second
+	DefaultConcurrency   = 8
+)
+
+// DialFunc connects through the server under test.
+type DialFunc func(ctx context.Context, network string, address string) (net.Conn, error)
+
+// Target is a server to test.
+type Target struct {
+	Tag  string
+	Type string
+	Dial DialFunc
+}
+
+type Options struct {
+	// URL is requested once to measure connect, tls and http latency.
+	URL string
+	// DownloadURL is downloaded up to DownloadLimit bytes to measure
+	// throughput; empty skips the download.
+	DownloadURL   string
+	DownloadLimit int64
+	// Timeout bounds all measurements of a target.
+	Timeout     time.Duration
+	Concurrency int
+	// TLSConfig is cloned for https requests, nil uses the system roots.
+	TLSConfig *tls.Config
+}
+
+// Result holds the measurements of a target. Durations are zero and Error is
+// set from the step that failed onwards.
+type Result struct {
+	Tag          string
+	Type         string
+	Connect      time.Duration
+	TLSHandshake time.Duration
+	Latency      time.Duration
+	// Throughput is in bytes per second.
+	Throughput float64
+	Downloaded int64
+	Error      string
+}
+
+func (r Result) OK() bool {
+	return r.Error == ""
+}
+
+// Total is the time from dialing to the http response.
+func (r Result) Total() time.Duration {
+	return r.Connect + r.TLSHandshake + r.Latency
+}
+
+func (r Result) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Tag          string  `json:"tag"`
+		Type         string  `json:"type,omitempty"`
+		Connect      float64 `json:"connect_ms"`
+		TLSHandshake float64 `json:"tls_ms"`
+		Latency      float64 `json:"latency_ms"`
+		Total        float64 `json:"total_ms"`
+		Throughput   float64 `json:"throughput_bps"`
+		Downloaded   int64   `json:"downloaded"`
+		Error        string  `json:"error,omitempty"`
+	}{r.Tag, r.Type, milliseconds(r.Connect), milliseconds(r.TLSHandshake), milliseconds(r.Latency), milliseconds(r.Total()), r.Throughput, r.Downloaded, r.Error})
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+func (o Options) withDefaults() Options {
+	if o.URL == "" {
+		o.URL = DefaultURL
+	}
+	if o.DownloadLimit <= 0 {
+		o.DownloadLimit = DefaultDownloadLimit
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultTimeout
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultConcurrency
+	}
+	return o
+}
+
+// Run tests the targets, at most Concurrency at a time, and returns the
+// results sorted by Sort.
+func Run(ctx context.Context, targets []Target, options Options) []Result {
+	options = options.withDefaults()
+	results := make([]Result, len(targets))
+	slots := make(chan struct{}, options.Concurrency)
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target Target) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+				results[i] = Measure(ctx, target, options)
+			case <-ctx.Done():
+				results[i] = Result{Tag: target.Tag, Type: target.Type, Error: ctx.Err().Error()}
+			}
+		}(i, target)
+	}
+	wg.Wait()
+	Sort(results)
+	return results
+}
+
+// Sort orders working servers by total time, followed by the failed ones by
+// tag.
+func Sort(results []Result) {
+	sort.SliceStable(results, func(a, b int) bool {
+		ra, rb := results[a], results[b]
+		if ra.OK() != rb.OK() {
+			return ra.OK()
+		}
+		if ra.OK() && ra.Total() != rb.Total() {
+			return ra.Total() < rb.Total()
+		}
+		return ra.Tag < rb.Tag
+	})
+}
+
+// Measure tests a single target.
+func Measure(ctx context.Context, target Target, options Options) Result {
+	options = options.withDefaults()
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+	result := Result{Tag: target.Tag, Type: target.Type}
+	if err := measureLatency(ctx, target.Dial, options, &result); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if options.DownloadURL != "" {
+		if err := measureThroughput(ctx, target.Dial, options, &result); err != nil {
+			result.Error = err.Error()
+		}
+	}
+	return result
+}
+
+func measureLatency(ctx context.Context, dial DialFunc, options Options, result *Result) error {
+	target, err := url.Parse(options.URL)
+	if err != nil {
+		return err
+	}
+	secure := target.Scheme == "https"
+	if !secure && target.Scheme != "http" {
+		return fmt.Errorf("unsupported url scheme %s", target.Scheme)
+	}
+	port := target.Port()
+	if port == "" && secure {
+		port = "443"
+	} else if port == "" {
+		port = "80"
+	}
+	address := net.JoinHostPort(target.Hostname(), port)
+
+	start := time.Now()
+	conn, err := dial(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close()
+	result.Connect = time.Since(start)
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if secure {
+		tlsConfig := tlsConfigFor(options, target.Hostname())
+		tlsConn := tls.Client(conn, tlsConfig)
+		start = time.Now()
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return fmt.Errorf("tls: %w", err)
+		}
+		result.TLSHandshake = time.Since(start)
+		conn = tlsConn
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, options.URL, nil)
+	if err != nil {
+		return err
+	}
+	request.Close = true
+	start = time.Now()
+	if err := request.Write(conn); err != nil {
+		return fmt.Errorf("http: %w", err)
+	}
+	response, err := http.ReadResponse(bufio.NewReader(conn), request)
+	if err != nil {
+		return fmt.Errorf("http: %w", err)
+	}
+	result.Latency = time.Since(start)
+	response.Body.Close()
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("http: status %d", response.StatusCode)
+	}
+	return nil
+}
+
+func measureThroughput(ctx context.Context, dial DialFunc, options Options, result *Result) error {
+	target, err := url.Parse(options.DownloadURL)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext:       dial,
+			TLSClientConfig:   tlsConfigFor(options, target.Hostname()),
+			DisableKeepAlives: true,
+		},
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, options.DownloadURL, nil)
+	if err != nil {
+		return err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("download: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("download: status %d", response.StatusCode)
+	}
+	start := time.Now()
+	downloaded, err := io.CopyN(io.Discard, response.Body, options.DownloadLimit)
+	elapsed := time.Since(start)
+	result.Downloaded = downloaded
+	if elapsed > 0 {
+		result.Throughput = float64(downloaded) / elapsed.Seconds()
+	}
+	// a timeout ends the download but still leaves a throughput
+	if err != nil && err != io.EOF && (downloaded == 0 || ctx.Err() == nil) {
+		return fmt.Errorf("download: %w", err)
+	}
+	return nil
+}
+
+func tlsConfigFor(options Options, serverName string) *tls.Config {
+	var tlsConfig *tls.Config
+	if options.TLSConfig != nil {
+		tlsConfig = options.TLSConfig.Clone()
+	} else {
+		tlsConfig = &tls.Config{}
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = serverName
+	}
+	return tlsConfig
+}
@@ -0,0 +1,157 @@
+package servertest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// slowConn delays every write, like a far away server.
+type slowConn struct {
+	net.Conn
+}
+
+func (c slowConn) Write(p []byte) (int, error) {
+	time.Sleep(20 * time.Millisecond)
+	return c.Conn.Write(p)
+}
+
+func TestRun(t *testing.T) {
+	payload := strings.Repeat("x", 64*1024)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/down" {
+			w.Write([]byte(payload))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var dialer net.Dialer
+	slow := func(ctx context.Context, network string, address string) (net.Conn, error) {
+		time.Sleep(50 * time.Millisecond)
+		conn, err := dialer.DialContext(ctx, network, address)
+		return slowConn{conn}, err
+	}
+	// every target reaches the stand-in server, whatever address it is given
+	direct := func(ctx context.Context, network string, address string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	refused := func(ctx context.Context, network string, address string) (net.Conn, error) {
+		return nil, &net.OpError{Op: "dial", Net: network, Err: net.UnknownNetworkError("refused")}
+	}
+	targets := []Target{
+		{Tag: "slow", Dial: slow},
+		{Tag: "down", Dial: refused},
+		{Tag: "fast", Dial: direct},
+	}
+	results := Run(context.Background(), targets, Options{
+		URL:         server.URL + "/generate_204",
+		DownloadURL: server.URL + "/down",
+		Concurrency: 2,
+		Timeout:     5 * time.Second,
+		TLSConfig:   &tls.Config{RootCAs: server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs, ServerName: "example.com"},
+	})
+
+	if len(results) != 3 || results[0].Tag != "fast" || results[1].Tag != "slow" || results[2].Tag != "down" {
+		t.Fatalf("unexpected order: %+v", results)
+	}
+	for _, result := range results[:2] {
+		if !result.OK() || result.Connect <= 0 || result.TLSHandshake <= 0 || result.Latency <= 0 {
+			t.Errorf("missing measurements: %+v", result)
+		}
+		if result.Downloaded != int64(len(payload)) || result.Throughput <= 0 {
+			t.Errorf("unexpected download: %+v", result)
+		}
+	}
+	if results[1].Connect < 50*time.Millisecond {
+		t.Errorf("connect time not measured: %v", results[1].Connect)
+	}
+	if results[2].OK() || !strings.HasPrefix(results[2].Error, "connect:") {
+		t.Errorf("expected connect error: %+v", results[2])
+	}
+
+	encoded, err := json.Marshal(results[2])
+	if err != nil || !strings.Contains(string(encoded), `"tag":"down"`) || !strings.Contains(string(encoded), `"error":"connect:`) {
+		t.Errorf("unexpected json: %s %v", encoded, err)
+	}
+}
+
+func TestSort(t *testing.T) {
+	results := []Result{
+		{Tag: "failed", Error: "connect: refused"},
+		{Tag: "far", Connect: 300 * time.Millisecond, TLSHandshake: 300 * time.Millisecond, Latency: 10 * time.Millisecond},
+		{Tag: "near", Connect: 20 * time.Millisecond, TLSHandshake: 20 * time.Millisecond, Latency: 50 * time.Millisecond},
+		{Tag: "broken", Error: "tls: eof"},
+	}
+	Sort(results)
+	var tags []string
+	for _, result := range results {
+		tags = append(tags, result.Tag)
+	}
+	if strings.Join(tags, ",") != "near,far,broken,failed" {
+		t.Errorf("unexpected order: %v", tags)
+	}
+}
+
+func TestMeasureStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	var dialer net.Dialer
+	result := Measure(context.Background(), Target{Tag: "bad", Dial: dialer.DialContext}, Options{URL: server.URL})
+	if result.OK() || result.Latency <= 0 || result.TLSHandshake != 0 {
+		t.Errorf("expected status error: %+v", result)
+	}
+}
+
+func TestSpeedTest(t *testing.T) {
+	var uploaded atomic.Int64
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/down":
+			w.Write(make([]byte, 256*1024))
+		case "/up":
+			n, _ := io.Copy(io.Discard, r.Body)
+			uploaded.Add(n)
+		case "/ping":
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	var dialer net.Dialer
+	result, err := SpeedTest(context.Background(), dialer.DialContext, SpeedOptions{
+		URL:         server.URL + "/ping",
+		Pings:       3,
+		DownloadURL: server.URL + "/down",
+		UploadURL:   server.URL + "/up",
+		Duration:    300 * time.Millisecond,
+		Streams:     2,
+		TLSConfig:   &tls.Config{RootCAs: server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs, ServerName: "example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Latency <= 0 || result.Downloaded < 256*1024 || result.DownloadMbps <= 0 || result.Uploaded <= 0 || result.UploadMbps <= 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	// only answered chunks count, the server also got part of the last ones
+	if received := uploaded.Load(); result.Uploaded > received || result.Uploaded < received*3/4 {
+		t.Errorf("server got %d bytes, test counted %d", received, result.Uploaded)
+	}
+
+	_, err = SpeedTest(context.Background(), dialer.DialContext, SpeedOptions{URL: server.URL + "/ping", Pings: 1, DownloadURL: server.URL + "/missing", Duration: 100 * time.Millisecond, TLSConfig: &tls.Config{RootCAs: server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs, ServerName: "example.com"}})
+	if err == nil || !strings.Contains(err.Error(), "status 404") {
+		t.Errorf("expected download error, got %v", err)
+	}
+}
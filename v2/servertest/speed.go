@@ -0,0 +1,289 @@
+package servertest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultSpeedDownloadURL = "https://speed.cloudflare.com/__down?bytes=100000000"
+	DefaultSpeedUploadURL   = "https://speed.cloudflare.com/__up"
+	DefaultSpeedDuration    = 10 * time.Second
+	DefaultSpeedStreams     = 4
+	DefaultSpeedPings       = 5
+)
+
+type SpeedOptions struct {
+	// URL is requested Pings times for latency and jitter.
+	URL   string
+	Pings int
+	// DownloadURL is fetched and UploadURL is posted to by Streams
+	// connections at once for Duration each; an empty url skips its
+	// direction.
+	DownloadURL string
+	UploadURL   string
+	Duration    time.Duration
+	Streams     int
+	// TLSConfig is cloned for https requests, nil uses the system roots.
+	TLSConfig *tls.Config
+}
+
+// SpeedResult is the outcome of a speed test. Mbps are megabits per second.
+type SpeedResult struct {
+	Latency      time.Duration
+	Jitter       time.Duration
+	DownloadMbps float64
+	UploadMbps   float64
+	Downloaded   int64
+	Uploaded     int64
+}
+
+func (r SpeedResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Latency      float64 `json:"latency_ms"`
+		Jitter       float64 `json:"jitter_ms"`
+		DownloadMbps float64 `json:"download_mbps"`
+		UploadMbps   float64 `json:"upload_mbps"`
+		Downloaded   int64   `json:"downloaded"`
+		Uploaded     int64   `json:"uploaded"`
+	}{milliseconds(r.Latency), milliseconds(r.Jitter), r.DownloadMbps, r.UploadMbps, r.Downloaded, r.Uploaded})
+}
+
+func (o SpeedOptions) withDefaults() SpeedOptions {
+	if o.URL == "" {
+		o.URL = DefaultURL
+	}
+	if o.Pings <= 0 {
+		o.Pings = DefaultSpeedPings
+	}
+	if o.Duration <= 0 {
+		o.Duration = DefaultSpeedDuration
+	}
+	if o.Streams <= 0 {
+		o.Streams = DefaultSpeedStreams
+	}
+	return o
+}
+
+// SpeedTest measures latency, jitter and the download and upload throughput
+// through dial.
+func SpeedTest(ctx context.Context, dial DialFunc, options SpeedOptions) (SpeedResult, error) {
+	options = options.withDefaults()
+	var result SpeedResult
+	err := measureJitter(ctx, dial, options, &result)
+	if err != nil {
+		return result, err
+	}
+	if options.DownloadURL != "" {
+		result.Downloaded, result.DownloadMbps, err = measureTransfer(ctx, dial, options, options.DownloadURL, download)
+		if err != nil {
+			return result, fmt.Errorf("download: %w", err)
+		}
+	}
+	if options.UploadURL != "" {
+		result.Uploaded, result.UploadMbps, err = measureTransfer(ctx, dial, options, options.UploadURL, upload)
+		if err != nil {
+			return result, fmt.Errorf("upload: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// measureJitter sends Pings requests over a single connection. Jitter is the
+// mean difference between consecutive latencies.
+func measureJitter(ctx context.Context, dial DialFunc, options SpeedOptions, result *SpeedResult) error {
+	client := speedClient(dial, options.TLSConfig, 1)
+	defer client.CloseIdleConnections()
+	var latencies []time.Duration
+	for i := 0; i <= options.Pings; i++ {
+		request, err := http.NewRequestWithContext(ctx, http.MethodHead, options.URL, nil)
+		if err != nil {
+			return err
+		}
+		start := time.Now()
+		response, err := client.Do(request)
+		if err != nil {
+			return fmt.Errorf("latency: %w", err)
+		}
+		io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+		// the first request also connects
+		if i > 0 {
+			latencies = append(latencies, time.Since(start))
+		}
+	}
+	var sum, variation time.Duration
+	for i, latency := range latencies {
+		sum += latency
+		if i > 0 {
+			variation += (latency - latencies[i-1]).Abs()
+		}
+	}
+	result.Latency = sum / time.Duration(len(latencies))
+	if len(latencies) > 1 {
+		result.Jitter = variation / time.Duration(len(latencies)-1)
+	}
+	return nil
+}
+
+type transferDirection int
+
+const (
+	download transferDirection = iota
+	upload
+)
+
+// Uploads are sent in chunks that only count once the server answers, so
+// the bytes still buffered on the way when the test ends are left out. A
+// chunk starts at uploadMinChunk and doubles while it takes less than a
+// twentieth of Duration, which keeps the unanswered part small.
+const (
+	uploadMinChunk = 128 * 1024
+	uploadMaxChunk = 25_000_000
+)
+
+// transferCounter sums the bytes moved and when the last of them arrived.
+type transferCounter struct {
+	bytes atomic.Int64
+	last  atomic.Int64
+}
+
+func (c *transferCounter) add(n int64) {
+	if n > 0 {
+		c.bytes.Add(n)
+		c.last.Store(time.Now().UnixNano())
+	}
+}
+
+// measureTransfer runs Streams connections that repeat the transfer until
+// Duration passes, and returns the bytes moved and the rate in Mbps.
+func measureTransfer(ctx context.Context, dial DialFunc, options SpeedOptions, url string, direction transferDirection) (int64, float64, error) {
+	client := speedClient(dial, options.TLSConfig, options.Streams)
+	defer client.CloseIdleConnections()
+	ctx, cancel := context.WithTimeout(ctx, options.Duration)
+	defer cancel()
+
+	var counter transferCounter
+	var wg sync.WaitGroup
+	errs := make([]error, options.Streams)
+	start := time.Now()
+	for i := 0; i < options.Streams; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			chunk := int64(uploadMinChunk)
+			for ctx.Err() == nil {
+				var err error
+				if direction == download {
+					err = downloadOnce(ctx, client, url, &counter)
+				} else {
+					sent := time.Now()
+					err = uploadOnce(ctx, client, url, chunk, &counter)
+					if time.Since(sent) < options.Duration/20 {
+						chunk = min(chunk*2, uploadMaxChunk)
+					}
+				}
+				if err != nil && ctx.Err() == nil {
+					errs[i] = err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	bytes := counter.bytes.Load()
+	if bytes == 0 {
+		if err := errors.Join(errs...); err != nil {
+			return 0, 0, err
+		}
+		return 0, 0, fmt.Errorf("nothing transferred")
+	}
+	elapsed := time.Unix(0, counter.last.Load()).Sub(start)
+	if elapsed <= 0 {
+		return bytes, 0, nil
+	}
+	return bytes, float64(bytes) * 8 / elapsed.Seconds() / 1_000_000, nil
+}
+
+func downloadOnce(ctx context.Context, client *http.Client, url string, counter *transferCounter) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", response.StatusCode)
+	}
+	buffer := make([]byte, 32*1024)
+	for {
+		n, err := response.Body.Read(buffer)
+		counter.add(int64(n))
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func uploadOnce(ctx context.Context, client *http.Client, url string, size int64, counter *transferCounter) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &uploadBody{remaining: size})
+	if err != nil {
+		return err
+	}
+	request.ContentLength = size
+	request.Header.Set("Content-Type", "application/octet-stream")
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, response.Body)
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("status %d", response.StatusCode)
+	}
+	counter.add(size)
+	return nil
+}
+
+// uploadBody is a stream of remaining zeros.
+type uploadBody struct {
+	remaining int64
+}
+
+func (b *uploadBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	clear(p)
+	b.remaining -= int64(len(p))
+	return len(p), nil
+}
+
+func speedClient(dial DialFunc, tlsConfig *tls.Config, streams int) *http.Client {
+	if tlsConfig != nil {
+		tlsConfig = tlsConfig.Clone()
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dial,
+			TLSClientConfig:     tlsConfig,
+			MaxIdleConnsPerHost: streams,
+		},
+	}
+}
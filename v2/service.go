@@ -8,6 +8,7 @@ import (
 	runtimeDebug "runtime/debug"
 	"time"
 
+	"github.com/hiddify/hiddify-core/config"
 	"github.com/hiddify/hiddify-core/v2/service_manager"
 
 	B "github.com/sagernet/sing-box"
@@ -67,20 +68,81 @@ func Setup(basePath string, workingPath string, tempPath string, statusPort int6
 }
 
 func NewService(options option.Options) (*libbox.BoxService, error) {
+	return newService(options, serviceOptions{})
+}
+
+type serviceOptions struct {
+	// logWriter receives the logs of the box besides its log output.
+	logWriter log.PlatformWriter
+	// logOutput, if set, receives the logs of the groups instead of stdout.
+	logOutput io.Writer
+	// isolated services leave the outbound health and the groups of the main
+	// core alone. They run their own groups with options and count their
+	// traffic without a clash api.
+	isolated bool
+	options  *config.HiddifyOptions
+}
+
+func newService(options option.Options, serviceOpt serviceOptions) (*libbox.BoxService, error) {
 	runtimeDebug.FreeOSMemory()
 	ctx, cancel := context.WithCancel(context.Background())
 	ctx = filemanager.WithDefault(ctx, sWorkingPath, sTempPath, sUserID, sGroupID)
 	urlTestHistoryStorage := urltest.NewHistoryStorage()
+	seeded := map[string]time.Time{}
+	if !serviceOpt.isolated && (HiddifyOptions == nil || HiddifyOptions.SeedURLTestFromHistory) {
+		seeded = seedURLTestHistory(urlTestHistoryStorage, options)
+	}
 	ctx = service.ContextWithPtr(ctx, urlTestHistoryStorage)
 	instance, err := B.New(B.Options{
-		Context: ctx,
-		Options: options,
+		Context:           ctx,
+		Options:           options,
+		PlatformLogWriter: serviceOpt.logWriter,
 	})
 	if err != nil {
 		cancel()
 		return nil, E.Cause(err, "create service")
 	}
 	runtimeDebug.FreeOSMemory()
+	if serviceOpt.isolated {
+		var groups *groupController
+		if serviceOpt.options != nil {
+			groups = newGroupController(instance.Router(), serviceOpt.options)
+			if serviceOpt.logOutput != nil {
+				groups.log = writerLogFunc(serviceOpt.logOutput)
+			}
+			if err := groups.installBalancers(options); err != nil {
+				cancel()
+				return nil, E.Cause(err, "create service")
+			}
+		}
+		server := installInstanceServer(instance.Router())
+		go func() {
+			<-ctx.Done()
+			server.traffic.Close()
+		}()
+		if groups != nil {
+			go groups.run(ctx)
+		}
+	} else {
+		var groups *groupController
+		if HiddifyOptions != nil {
+			groups = newGroupController(instance.Router(), HiddifyOptions)
+			if err := groups.installBalancers(options); err != nil {
+				cancel()
+				return nil, E.Cause(err, "create service")
+			}
+		}
+		server := installRoutedServer(instance.Router(), accounting)
+		go func() {
+			<-ctx.Done()
+			server.traffic.Close()
+		}()
+		if groups != nil {
+			groups.publish()
+			go groups.run(ctx)
+		}
+		go newHealthRecorder(urlTestHistoryStorage, options, seeded).run(ctx)
+	}
 	service := libbox.NewBoxService(
 		ctx,
 		cancel,
@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/servertest"
+)
+
+// SpeedTest measures throughput through the running core or a created
+// instance. It blocks for the whole test, twice the duration at most.
+func (s *CoreService) SpeedTest(ctx context.Context, in *pb.SpeedTestRequest) (*pb.SpeedTestResponse, error) {
+	return SpeedTest(ctx, in)
+}
+
+func SpeedTest(ctx context.Context, in *pb.SpeedTestRequest) (*pb.SpeedTestResponse, error) {
+	var dialer *Dialer
+	if in.Instance != "" {
+		instance, err := instances.get(in.Instance)
+		if err != nil || instance == nil {
+			return speedTestFailed(fmt.Errorf("instance %s not found", in.Instance))
+		}
+		dialer = instance.service.Dialer(in.Outbound)
+	} else {
+		if Box == nil {
+			return speedTestFailed(fmt.Errorf("instance not started"))
+		}
+		dialer = &Dialer{router: Box.GetInstance().Router(), outbound: in.Outbound}
+	}
+
+	options := servertest.SpeedOptions{
+		URL:         in.Url,
+		DownloadURL: in.DownloadUrl,
+		UploadURL:   in.UploadUrl,
+		Duration:    time.Duration(in.Duration) * time.Millisecond,
+		Streams:     int(in.Streams),
+	}
+	if options.DownloadURL == "" {
+		options.DownloadURL = servertest.DefaultSpeedDownloadURL
+	}
+	if options.UploadURL == "" {
+		options.UploadURL = servertest.DefaultSpeedUploadURL
+	}
+	if in.SkipDownload {
+		options.DownloadURL = ""
+	}
+	if in.SkipUpload {
+		options.UploadURL = ""
+	}
+	result, err := dialer.SpeedTest(ctx, options)
+	if err != nil {
+		return speedTestFailed(err)
+	}
+	return &pb.SpeedTestResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Latency:      uint32(result.Latency.Milliseconds()),
+		Jitter:       uint32(result.Jitter.Milliseconds()),
+		DownloadMbps: result.DownloadMbps,
+		UploadMbps:   result.UploadMbps,
+		Downloaded:   result.Downloaded,
+		Uploaded:     result.Uploaded,
+	}, nil
+}
+
+func speedTestFailed(err error) (*pb.SpeedTestResponse, error) {
+	return &pb.SpeedTestResponse{
+		ResponseCode: pb.ResponseCode_FAILED,
+		Message:      err.Error(),
+	}, err
+}
@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
-	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
@@ -27,6 +26,8 @@ func RunStandalone(hiddifySettingPath string, configPath string, defaultConfig c
 		fmt.Printf("Error in read and build config %v", err)
 		return err
 	}
+	// the firewall rules of the transparent proxy follow these options
+	HiddifyOptions = current.HiddifyHiddifyOptions
 
 	go StartService(&pb.StartRequest{
 		ConfigContent:          current.Config,
@@ -50,16 +51,23 @@ func RunStandalone(hiddifySettingPath string, configPath string, defaultConfig c
 type ConfigResult struct {
 	Config                string
 	RefreshInterval       int
+	SubscriptionInfo      *config.SubscriptionInfo
 	HiddifyHiddifyOptions *config.HiddifyOptions
 }
 
 func readAndBuildConfig(hiddifySettingPath string, configPath string, defaultConfig *config.HiddifyOptions) (ConfigResult, error) {
 	var result ConfigResult
 
-	result, err := readConfigContent(configPath)
+	result, err := ReadConfigContent(configPath)
 	if err != nil {
 		return result, err
 	}
+	if strings.HasPrefix(configPath, "http://") || strings.HasPrefix(configPath, "https://") {
+		fmt.Printf("Refresh interval: %d\n", result.RefreshInterval)
+		if info := result.SubscriptionInfo; info != nil {
+			fmt.Printf("Subscription: upload=%d download=%d total=%d expire=%d\n", info.Upload, info.Download, info.Total, info.Expire)
+		}
+	}
 
 	hiddifyconfig := config.DefaultHiddifyOptions()
 
@@ -83,45 +91,34 @@ func readAndBuildConfig(hiddifySettingPath string, configPath string, defaultCon
 	return result, nil
 }
 
-func readConfigContent(configPath string) (ConfigResult, error) {
+// ReadConfigContent reads a config from a local file or a subscription url.
+func ReadConfigContent(configPath string) (ConfigResult, error) {
 	var content string
 	var refreshInterval int
+	var info *config.SubscriptionInfo
 
 	if strings.HasPrefix(configPath, "http://") || strings.HasPrefix(configPath, "https://") {
-		client := &http.Client{}
-
-		// Create a new request
-		req, err := http.NewRequest("GET", configPath, nil)
-		if err != nil {
-			fmt.Println("Error creating request:", err)
-			return ConfigResult{}, err
-		}
-		req.Header.Set("User-Agent", "HiddifyNext/2.3.1 ("+runtime.GOOS+") like ClashMeta v2ray sing-box")
-		resp, err := client.Do(req)
+		sub, err := fetchSubscription(configPath, nil, "")
 		if err != nil {
 			fmt.Println("Error making GET request:", err)
 			return ConfigResult{}, err
 		}
-		defer resp.Body.Close()
-
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return ConfigResult{}, fmt.Errorf("failed to read config body: %w", err)
-		}
-		content = string(body)
-		refreshInterval, _ = extractRefreshInterval(resp.Header, content)
-		fmt.Printf("Refresh interval: %d\n", refreshInterval)
+		content = sub.Content
+		refreshInterval = sub.RefreshInterval
+		info = sub.Info
 	} else {
 		data, err := ioutil.ReadFile(configPath)
 		if err != nil {
 			return ConfigResult{}, fmt.Errorf("failed to read config file: %w", err)
 		}
 		content = string(data)
+		info = config.ParseSubscriptionInfo(nil, content)
 	}
 
 	return ConfigResult{
-		Config:          content,
-		RefreshInterval: refreshInterval,
+		Config:           content,
+		RefreshInterval:  refreshInterval,
+		SubscriptionInfo: info,
 	}, nil
 }
 
@@ -200,14 +197,18 @@ func updateConfigInterval(current ConfigResult, hiddifySettingPath string, confi
 			continue
 		}
 		if new.Config != current.Config {
-			go Stop()
-			go StartService(&pb.StartRequest{
+			HiddifyOptions = new.HiddifyHiddifyOptions
+			Stop()
+			// the old core has to release its ports and firewall rules first
+			if _, err := StartService(&pb.StartRequest{
 				ConfigContent:          new.Config,
 				DelayStart:             false,
 				EnableOldCommandServer: false,
 				DisableMemoryLimit:     false,
 				EnableRawConfig:        true,
-			})
+			}); err != nil {
+				Log(pb.LogLevel_ERROR, pb.LogType_CORE, err.Error())
+			}
 		}
 		current = new
 	}
@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+)
+
+const subscriptionUserAgent = "HiddifyNext/2.3.1 (" + runtime.GOOS + ") like ClashMeta v2ray sing-box"
+
+type subscriptionResponse struct {
+	Content         string
+	ETag            string
+	NotModified     bool
+	Header          http.Header
+	RefreshInterval int
+	Info            *config.SubscriptionInfo
+}
+
+// fetchSubscription downloads a subscription. When etag is set the request is
+// conditional and NotModified reports a 304 from the provider.
+func fetchSubscription(url string, headers map[string]string, etag string) (*subscriptionResponse, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", subscriptionUserAgent)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return &subscriptionResponse{
+			ETag:        etag,
+			NotModified: true,
+			Header:      resp.Header,
+			Info:        config.ParseSubscriptionInfo(resp.Header, ""),
+		}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status code: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config body: %w", err)
+	}
+	content := string(body)
+	refreshInterval, _ := extractRefreshInterval(resp.Header, content)
+
+	return &subscriptionResponse{
+		Content:         content,
+		ETag:            resp.Header.Get("ETag"),
+		Header:          resp.Header,
+		RefreshInterval: refreshInterval,
+		Info:            config.ParseSubscriptionInfo(resp.Header, content),
+	}, nil
+}
+
+func subscriptionInfoToPb(info *config.SubscriptionInfo) *pb.SubscriptionInfo {
+	if info == nil {
+		return nil
+	}
+	return &pb.SubscriptionInfo{
+		Upload:     info.Upload,
+		Download:   info.Download,
+		Total:      info.Total,
+		Expire:     info.Expire,
+		Title:      info.Title,
+		SupportUrl: info.SupportURL,
+		WebPageUrl: info.WebPageURL,
+	}
+}
@@ -0,0 +1,301 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+	"github.com/hiddify/hiddify-core/v2/service_manager"
+)
+
+const (
+	trafficPollInterval  = time.Second
+	trafficFlushInterval = 30 * time.Second
+	trafficDayLayout     = "2006-01-02"
+)
+
+var trafficKinds = map[pb.TrafficKind]string{
+	pb.TrafficKind_TRAFFIC_OUTBOUND: "outbound",
+	pb.TrafficKind_TRAFFIC_RULE:     "rule",
+	pb.TrafficKind_TRAFFIC_CLIENT:   "client",
+}
+
+// TrafficCounter is the traffic of one outbound tag or rule in one day.
+type TrafficCounter struct {
+	Id       string // day|kind|key
+	Day      string
+	Kind     string
+	Key      string
+	Upload   int64
+	Download int64
+}
+
+func trafficCounterId(day string, kind string, key string) string {
+	return day + "|" + kind + "|" + key
+}
+
+type connectionUsage struct {
+	upload   int64
+	download int64
+	// closed connections are already counted in full and ignored by a poll
+	// that raced with their close
+	closed bool
+}
+
+// trafficAccounting polls the connections of the running instance and adds
+// the traffic of each one to its outbound, rule and LAN client counters. The
+// rest of the traffic of a connection is added when it closes, so short
+// connections that never show up in a poll are counted as well. Counters are
+// kept in memory and flushed to the db periodically and on close.
+type trafficAccounting struct {
+	done    chan struct{}
+	access  sync.Mutex
+	seen    map[string]connectionUsage
+	pending map[string]*TrafficCounter
+	// flushing keeps concurrent flushes from overwriting each other's sums
+	flushing sync.Mutex
+}
+
+func (t *trafficAccounting) Start() error {
+	t.done = make(chan struct{})
+	t.seen = map[string]connectionUsage{}
+	t.pending = map[string]*TrafficCounter{}
+	go t.loop(t.done)
+	return nil
+}
+
+// Close never fails, so that the services registered after it are closed
+// too; the traffic that can not be stored is logged and dropped.
+func (t *trafficAccounting) Close() error {
+	if t.done != nil {
+		close(t.done)
+		t.done = nil
+	}
+	if err := t.flush(); err != nil {
+		Log(pb.LogLevel_WARNING, pb.LogType_CORE, "failed to store traffic: "+err.Error())
+	}
+	return nil
+}
+
+func (t *trafficAccounting) loop(done chan struct{}) {
+	poll := time.NewTicker(trafficPollInterval)
+	defer poll.Stop()
+	flush := time.NewTicker(trafficFlushInterval)
+	defer flush.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-poll.C:
+			t.poll()
+		case <-flush.C:
+			if err := t.flush(); err != nil {
+				Log(pb.LogLevel_WARNING, pb.LogType_CORE, "failed to store traffic: "+err.Error())
+			}
+		}
+	}
+}
+
+func (t *trafficAccounting) poll() {
+	infos, err := snapshotConnections()
+	if err != nil {
+		t.access.Lock()
+		t.seen = map[string]connectionUsage{}
+		t.access.Unlock()
+		return
+	}
+	day := time.Now().Format(trafficDayLayout)
+	current := make(map[string]connectionUsage, len(infos))
+
+	t.access.Lock()
+	defer t.access.Unlock()
+	for i := range infos {
+		info := &infos[i]
+		if last := t.seen[info.Id]; last.closed {
+			current[info.Id] = last
+			continue
+		}
+		current[info.Id] = t.count(day, info)
+	}
+	t.seen = current
+}
+
+// closed adds the traffic of a connection since the last poll once it is
+// closed.
+func (t *trafficAccounting) closed(info trackerInfo) {
+	day := time.Now().Format(trafficDayLayout)
+
+	t.access.Lock()
+	defer t.access.Unlock()
+	if t.seen == nil || t.seen[info.Id].closed {
+		return
+	}
+	usage := t.count(day, &info)
+	usage.closed = true
+	t.seen[info.Id] = usage
+}
+
+// count adds the traffic of info since it was last seen and returns its
+// current usage. It is called with access held.
+func (t *trafficAccounting) count(day string, info *trackerInfo) connectionUsage {
+	usage := connectionUsage{upload: info.Upload, download: info.Download}
+	last := t.seen[info.Id]
+	upload, download := usage.upload-last.upload, usage.download-last.download
+	if upload <= 0 && download <= 0 {
+		return usage
+	}
+	if len(info.Chains) > 0 {
+		t.add(day, trafficKinds[pb.TrafficKind_TRAFFIC_OUTBOUND], info.Chains[0], upload, download)
+	}
+	if info.RuleKey != "" {
+		t.add(day, trafficKinds[pb.TrafficKind_TRAFFIC_RULE], info.RuleKey, upload, download)
+	}
+	if client, ok := lanClient(info); ok {
+		t.add(day, trafficKinds[pb.TrafficKind_TRAFFIC_CLIENT], client, upload, download)
+	}
+	return usage
+}
+
+// lanClient returns the LAN-share user of a connection, or the address of
+// its LAN client when it did not authenticate, or false for connections of
+// the host itself.
+func lanClient(info *trackerInfo) (string, bool) {
+	// the clash api reports the inbound as type/tag
+	inbound := info.Metadata.Type
+	if i := strings.LastIndex(inbound, "/"); i >= 0 {
+		inbound = inbound[i+1:]
+	}
+	if !slices.Contains(config.LANInboundTags, inbound) {
+		return "", false
+	}
+	addr, err := netip.ParseAddr(info.Metadata.SourceIP)
+	if err != nil || addr.IsLoopback() {
+		return "", false
+	}
+	if info.User != "" {
+		return info.User, true
+	}
+	return addr.Unmap().String(), true
+}
+
+func (t *trafficAccounting) add(day string, kind string, key string, upload int64, download int64) {
+	id := trafficCounterId(day, kind, key)
+	counter, ok := t.pending[id]
+	if !ok {
+		counter = &TrafficCounter{Id: id, Day: day, Kind: kind, Key: key}
+		t.pending[id] = counter
+	}
+	counter.Upload += max(upload, 0)
+	counter.Download += max(download, 0)
+}
+
+func (t *trafficAccounting) flush() error {
+	t.flushing.Lock()
+	defer t.flushing.Unlock()
+	t.access.Lock()
+	pending := t.pending
+	t.pending = map[string]*TrafficCounter{}
+	t.access.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	table := db.GetTable[TrafficCounter]()
+	counters := make([]*TrafficCounter, 0, len(pending))
+	for id, delta := range pending {
+		counter, err := table.Get(id)
+		if err != nil || counter == nil || counter.Id == "" {
+			counter = &TrafficCounter{Id: id, Day: delta.Day, Kind: delta.Kind, Key: delta.Key}
+		}
+		counter.Upload += delta.Upload
+		counter.Download += delta.Download
+		counters = append(counters, counter)
+	}
+	return table.UpdateInsert(counters...)
+}
+
+func (s *CoreService) QueryTraffic(ctx context.Context, in *pb.TrafficQuery) (*pb.TrafficReport, error) {
+	return QueryTraffic(in)
+}
+
+// QueryTraffic returns the stored traffic of the requested kind for the days
+// between from and to, either per day or summed over the whole range.
+func QueryTraffic(in *pb.TrafficQuery) (*pb.TrafficReport, error) {
+	kind, ok := trafficKinds[in.Kind]
+	if !ok {
+		err := fmt.Errorf("unknown traffic kind %v", in.Kind)
+		return &pb.TrafficReport{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+	}
+	if accounting != nil {
+		if err := accounting.flush(); err != nil {
+			return &pb.TrafficReport{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+		}
+	}
+	counters, err := db.GetTable[TrafficCounter]().All()
+	if err != nil {
+		return &pb.TrafficReport{ResponseCode: pb.ResponseCode_FAILED, Message: err.Error()}, err
+	}
+	return &pb.TrafficReport{
+		ResponseCode: pb.ResponseCode_OK,
+		Items:        trafficReportItems(counters, in.Kind, kind, in.From, in.To, in.ByDay),
+	}, nil
+}
+
+func trafficReportItems(counters []*TrafficCounter, pbKind pb.TrafficKind, kind string, from int64, to int64, byDay bool) []*pb.TrafficRecord {
+	var fromDay, toDay string
+	if from > 0 {
+		fromDay = time.Unix(from, 0).Format(trafficDayLayout)
+	}
+	if to > 0 {
+		toDay = time.Unix(to, 0).Format(trafficDayLayout)
+	}
+
+	items := map[string]*pb.TrafficRecord{}
+	for _, counter := range counters {
+		if counter.Kind != kind {
+			continue
+		}
+		// days are formatted as YYYY-MM-DD so they compare as strings
+		if fromDay != "" && counter.Day < fromDay || toDay != "" && counter.Day > toDay {
+			continue
+		}
+		day, id := "", counter.Key
+		if byDay {
+			day, id = counter.Day, counter.Id
+		}
+		item, ok := items[id]
+		if !ok {
+			item = &pb.TrafficRecord{Day: day, Kind: pbKind, Key: counter.Key}
+			items[id] = item
+		}
+		item.Upload += counter.Upload
+		item.Download += counter.Download
+	}
+
+	list := make([]*pb.TrafficRecord, 0, len(items))
+	for _, item := range items {
+		list = append(list, item)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Day != list[j].Day {
+			return list[i].Day < list[j].Day
+		}
+		return list[i].Key < list[j].Key
+	})
+	return list
+}
+
+var accounting *trafficAccounting
+
+func init() {
+	accounting = &trafficAccounting{}
+	service_manager.Register(accounting)
+}
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+)
+
+func TestTrafficAccountingClosed(t *testing.T) {
+	traffic := &trafficAccounting{
+		seen:    map[string]connectionUsage{},
+		pending: map[string]*TrafficCounter{},
+	}
+	outbound := trafficKinds[pb.TrafficKind_TRAFFIC_OUTBOUND]
+	usage := func(key string) (upload int64, download int64) {
+		for _, counter := range traffic.pending {
+			if counter.Kind == outbound && counter.Key == key {
+				upload += counter.Upload
+				download += counter.Download
+			}
+		}
+		return upload, download
+	}
+
+	// a connection that closes before any poll is counted in full
+	short := trackerInfo{Id: "short", Upload: 100, Download: 200, Chains: []string{"a"}}
+	traffic.closed(short)
+	if upload, download := usage("a"); upload != 100 || download != 200 {
+		t.Errorf("short connection: got %d/%d", upload, download)
+	}
+
+	// a polled connection only adds what it sent after the poll
+	long := trackerInfo{Id: "long", Upload: 10, Download: 20, Chains: []string{"b"}}
+	traffic.seen["long"] = traffic.count("day", &long)
+	long.Upload, long.Download = 15, 50
+	traffic.closed(long)
+	traffic.closed(long)
+	if upload, download := usage("b"); upload != 15 || download != 50 {
+		t.Errorf("long connection: got %d/%d", upload, download)
+	}
+	if !traffic.seen["long"].closed {
+		t.Error("closed connection should be ignored by the next poll")
+	}
+}
+
+func TestLANClient(t *testing.T) {
+	tests := []struct {
+		inbound string
+		source  string
+		user    string
+		want    string
+		ok      bool
+	}{
+		{"mixed/" + config.LANInboundTags[0], "192.168.1.20", "", "192.168.1.20", true},
+		{"mixed/" + config.LANInboundTags[0], "192.168.1.20", "guest", "guest", true},
+		{"mixed/" + config.LANInboundTags[0], "127.0.0.1", "guest", "", false},
+		{"tun/tun-in", "192.168.1.20", "", "", false},
+	}
+	for _, test := range tests {
+		var info trackerInfo
+		info.Metadata.Type = test.inbound
+		info.Metadata.SourceIP = test.source
+		info.User = test.user
+		if client, ok := lanClient(&info); client != test.want || ok != test.ok {
+			t.Errorf("%s from %s as %q: got %q/%v", test.inbound, test.source, test.user, client, ok)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package v2
+
+import (
+	"context"
+
+	"github.com/hiddify/hiddify-core/config"
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+)
+
+// warpVaultAccountToPb converts a vault entry, leaving out its private key
+// unless withPrivateKey is set.
+func warpVaultAccountToPb(entry *db.WarpVaultEntry, withPrivateKey bool) *pb.WarpVaultAccount {
+	account := &pb.WarpVaultAccount{
+		Id:          entry.Id,
+		Name:        entry.Name,
+		Key:         config.WarpVaultKey(entry.Id),
+		AccountId:   entry.DeviceID,
+		LicenseKey:  entry.License,
+		AccountType: entry.AccountType,
+		WarpPlus:    entry.WarpPlus,
+		Config: &pb.WarpWireguardConfig{
+			LocalAddressIpv4: entry.LocalAddressIPv4,
+			LocalAddressIpv6: entry.LocalAddressIPv6,
+			PeerPublicKey:    entry.PeerPublicKey,
+			ClientId:         entry.ClientID,
+		},
+		CreatedAt: entry.CreatedAt.Unix(),
+	}
+	if withPrivateKey {
+		account.Config.PrivateKey = entry.PrivateKey
+	}
+	if !entry.RotatedAt.IsZero() {
+		account.RotatedAt = entry.RotatedAt.Unix()
+	}
+	return account
+}
+
+func warpVaultResponse(entry *db.WarpVaultEntry, err error) (*pb.WarpVaultResponse, error) {
+	if err != nil {
+		return &pb.WarpVaultResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.WarpVaultResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Account:      warpVaultAccountToPb(entry, true),
+	}, nil
+}
+
+func (s *CoreService) ListWarpAccounts(ctx context.Context, in *pb.ListWarpAccountsRequest) (*pb.WarpVaultList, error) {
+	return ListWarpAccounts(in)
+}
+
+// ListWarpAccounts lists the vault accounts without their private keys,
+// unless the request includes them.
+func ListWarpAccounts(in *pb.ListWarpAccountsRequest) (*pb.WarpVaultList, error) {
+	entries, err := config.ListWarpVault()
+	if err != nil {
+		return &pb.WarpVaultList{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	list := &pb.WarpVaultList{ResponseCode: pb.ResponseCode_OK}
+	for _, entry := range entries {
+		list.Items = append(list.Items, warpVaultAccountToPb(entry, in.IncludePrivateKeys))
+	}
+	return list, nil
+}
+
+func (s *CoreService) CreateWarpAccount(ctx context.Context, in *pb.CreateWarpAccountRequest) (*pb.WarpVaultResponse, error) {
+	return CreateWarpAccount(in)
+}
+
+func CreateWarpAccount(in *pb.CreateWarpAccountRequest) (*pb.WarpVaultResponse, error) {
+	return warpVaultResponse(config.CreateWarpVaultEntry(in.Name, in.LicenseKey))
+}
+
+func (s *CoreService) ImportWarpAccount(ctx context.Context, in *pb.ImportWarpAccountRequest) (*pb.WarpVaultResponse, error) {
+	return ImportWarpAccount(in)
+}
+
+func ImportWarpAccount(in *pb.ImportWarpAccountRequest) (*pb.WarpVaultResponse, error) {
+	return warpVaultResponse(config.ImportWarpVaultEntry(in.Name, in.AccountId, in.AccessToken, in.PrivateKey))
+}
+
+func (s *CoreService) BindWarpLicense(ctx context.Context, in *pb.BindWarpLicenseRequest) (*pb.WarpVaultResponse, error) {
+	return BindWarpLicense(in)
+}
+
+func BindWarpLicense(in *pb.BindWarpLicenseRequest) (*pb.WarpVaultResponse, error) {
+	return warpVaultResponse(config.BindWarpVaultLicense(in.Id, in.LicenseKey))
+}
+
+func (s *CoreService) RotateWarpAccount(ctx context.Context, in *pb.WarpVaultRequest) (*pb.WarpVaultResponse, error) {
+	return RotateWarpAccount(in)
+}
+
+// RotateWarpAccount gives the account a new key pair. A running core that
+// uses the account has to be restarted to pick it up.
+func RotateWarpAccount(in *pb.WarpVaultRequest) (*pb.WarpVaultResponse, error) {
+	return warpVaultResponse(config.RotateWarpVaultEntry(in.Id))
+}
+
+func (s *CoreService) DeleteWarpAccount(ctx context.Context, in *pb.DeleteWarpAccountRequest) (*pb.Response, error) {
+	return DeleteWarpAccount(in)
+}
+
+func DeleteWarpAccount(in *pb.DeleteWarpAccountRequest) (*pb.Response, error) {
+	err := config.DeleteWarpVaultEntry(in.Id, in.KeepDevice)
+	if err != nil {
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
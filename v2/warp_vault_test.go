@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/hiddify/hiddify-core/hiddifyrpc"
+	"github.com/hiddify/hiddify-core/v2/db"
+)
+
+func TestListWarpAccounts(t *testing.T) {
+	t.Chdir(t.TempDir())
+	entry := &db.WarpVaultEntry{
+		Id:            "a1b2c3",
+		Name:          "main",
+		DeviceID:      "device",
+		AccessToken:   "token",
+		PrivateKey:    "private",
+		PeerPublicKey: "peer",
+		CreatedAt:     time.Now(),
+	}
+	if err := db.WarpVault().UpdateInsert(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := ListWarpAccounts(&pb.ListWarpAccountsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Config.PeerPublicKey != "peer" || list.Items[0].Config.PrivateKey != "" {
+		t.Fatalf("private key should be left out: %v", list.Items)
+	}
+
+	list, err = ListWarpAccounts(&pb.ListWarpAccountsRequest{IncludePrivateKeys: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Config.PrivateKey != "private" {
+		t.Fatalf("private key was asked for: %v", list.Items)
+	}
+}